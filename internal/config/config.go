@@ -3,22 +3,187 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+const (
+	StorageFirestore = "firestore"
+	StorageMemory    = "memory"
+	StorageSQLite    = "sqlite"
+	StoragePostgres  = "postgres"
+)
+
+const (
+	MailerLog  = "log"
+	MailerSMTP = "smtp"
+)
+
+const (
+	RegistrationOpen   = "open"
+	RegistrationDomain = "domain"
+	RegistrationInvite = "invite"
 )
 
 type Config struct {
-	ProjectID  string
-	ServerAddr string
-	Timezone   string
-	JWTSecret  string
+	ProjectID   string
+	ServerAddr  string
+	Timezone    string
+	JWTSecret   string
+	Storage     string
+	DatabaseURL string
+	AutoMigrate bool
+	CodePeriod  time.Duration
+	CheckInURL  string
+
+	CertificateVerifyURL string
+
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	Mailer       string
+	MailFrom     string
+	MailFile     string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	PasswordResetURL    string
+	PasswordResetTTL    time.Duration
+	PasswordResetLimit  int
+	PasswordResetWindow time.Duration
+
+	EmailVerifyURL       string
+	EmailVerifyTTL       time.Duration
+	RequireVerifiedEmail bool
+
+	RegistrationPolicy  string
+	RegistrationDomains []string
+	InviteTTL           time.Duration
+
+	TwoFactorIssuer       string
+	TwoFactorRoles        []string
+	TwoFactorChallengeTTL time.Duration
+
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+
+	ScheduleHorizonDays int
+	ScheduleInterval    time.Duration
+
+	AutoCloseCutoff       time.Duration
+	AutoCloseInterval     time.Duration
+	AutoCloseLookbackDays int
 }
 
 func Load() *Config {
-	return &Config{
-		ProjectID:  mustGetEnv("GOOGLE_PROJECT_ID"),
-		ServerAddr: getEnv("SERVER_ADDR", ":8080"),
-		Timezone:   getEnv("NITELOG_TIMEZONE", "America/Sao_Paulo"),
-		JWTSecret:  mustGetEnv("JWT_SECRET"),
+	cfg := &Config{
+		ProjectID:   os.Getenv("GOOGLE_PROJECT_ID"),
+		ServerAddr:  getEnv("SERVER_ADDR", ":8080"),
+		Timezone:    getEnv("NITELOG_TIMEZONE", "America/Sao_Paulo"),
+		JWTSecret:   mustGetEnv("JWT_SECRET"),
+		Storage:     getEnv("NITELOG_STORAGE", StorageFirestore),
+		DatabaseURL: os.Getenv("NITELOG_DATABASE_URL"),
+		AutoMigrate: getEnv("NITELOG_AUTO_MIGRATE", "true") == "true",
+		CodePeriod:  getEnvDuration("NITELOG_CODE_PERIOD", 30*time.Second),
+		CheckInURL:  getEnv("NITELOG_CHECKIN_URL", "https://nitelogdev.discloud.app/check-in"),
+
+		CertificateVerifyURL: getEnv("NITELOG_CERTIFICATE_VERIFY_URL", "https://nitelogdev.discloud.app/certificates/verify"),
+
+		AccessTokenTTL:  getEnvDuration("NITELOG_ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: getEnvDuration("NITELOG_REFRESH_TOKEN_TTL", 30*24*time.Hour),
+
+		Mailer:       getEnv("NITELOG_MAILER", MailerLog),
+		MailFrom:     getEnv("NITELOG_MAIL_FROM", "NITELog <no-reply@nitelog.discloud.app>"),
+		MailFile:     os.Getenv("NITELOG_MAIL_FILE"),
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     getEnvInt("SMTP_PORT", 587),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+
+		PasswordResetURL:    getEnv("NITELOG_PASSWORD_RESET_URL", "https://nitelogdev.discloud.app/reset-password"),
+		PasswordResetTTL:    getEnvDuration("NITELOG_PASSWORD_RESET_TTL", time.Hour),
+		PasswordResetLimit:  getEnvInt("NITELOG_PASSWORD_RESET_LIMIT", 3),
+		PasswordResetWindow: getEnvDuration("NITELOG_PASSWORD_RESET_WINDOW", time.Hour),
+
+		EmailVerifyURL:       getEnv("NITELOG_EMAIL_VERIFY_URL", "https://nitelogdev.discloud.app/verify-email"),
+		EmailVerifyTTL:       getEnvDuration("NITELOG_EMAIL_VERIFY_TTL", 48*time.Hour),
+		RequireVerifiedEmail: getEnv("NITELOG_REQUIRE_VERIFIED_EMAIL", "true") == "true",
+
+		RegistrationPolicy:  getEnv("NITELOG_REGISTRATION_POLICY", RegistrationOpen),
+		RegistrationDomains: getEnvList("NITELOG_REGISTRATION_DOMAINS"),
+		InviteTTL:           getEnvDuration("NITELOG_INVITE_TTL", 7*24*time.Hour),
+
+		TwoFactorIssuer:       getEnv("NITELOG_TWO_FACTOR_ISSUER", "NITELog"),
+		TwoFactorRoles:        getEnvList("NITELOG_TWO_FACTOR_ROLES"),
+		TwoFactorChallengeTTL: getEnvDuration("NITELOG_TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute),
+
+		PurgeRetention: time.Duration(getEnvInt("NITELOG_PURGE_RETENTION_DAYS", 90)) * 24 * time.Hour,
+		PurgeInterval:  getEnvDuration("NITELOG_PURGE_INTERVAL", 24*time.Hour),
+
+		ScheduleHorizonDays: getEnvInt("NITELOG_SCHEDULE_HORIZON_DAYS", 14),
+		ScheduleInterval:    getEnvDuration("NITELOG_SCHEDULE_INTERVAL", time.Hour),
+
+		AutoCloseCutoff:       getEnvDuration("NITELOG_AUTO_CLOSE_CUTOFF", 4*time.Hour),
+		AutoCloseInterval:     getEnvDuration("NITELOG_AUTO_CLOSE_INTERVAL", 15*time.Minute),
+		AutoCloseLookbackDays: getEnvInt("NITELOG_AUTO_CLOSE_LOOKBACK_DAYS", 30),
+	}
+
+	// Rotating codes count whole seconds since the epoch, a shorter or
+	// fractional period cannot be derived.
+	if cfg.CodePeriod < time.Second || cfg.CodePeriod%time.Second != 0 {
+		log.Fatalf("environment variable %s must be a whole number of seconds, got %s", "NITELOG_CODE_PERIOD", cfg.CodePeriod)
 	}
+
+	switch cfg.Storage {
+	case StorageFirestore:
+		if cfg.ProjectID == "" {
+			log.Fatalf("environment variable %s is required", "GOOGLE_PROJECT_ID")
+		}
+	case StorageMemory:
+	case StorageSQLite:
+		if cfg.DatabaseURL == "" {
+			cfg.DatabaseURL = "nitelog.db"
+		}
+	case StoragePostgres:
+		if cfg.DatabaseURL == "" {
+			log.Fatalf("environment variable %s is required", "NITELOG_DATABASE_URL")
+		}
+	default:
+		log.Fatalf("unknown storage backend %q", cfg.Storage)
+	}
+
+	switch cfg.Mailer {
+	case MailerLog:
+	case MailerSMTP:
+		if cfg.SMTPHost == "" {
+			log.Fatalf("environment variable %s is required", "SMTP_HOST")
+		}
+	default:
+		log.Fatalf("unknown mailer %q", cfg.Mailer)
+	}
+
+	switch cfg.RegistrationPolicy {
+	case RegistrationOpen, RegistrationInvite:
+	case RegistrationDomain:
+		if len(cfg.RegistrationDomains) == 0 {
+			log.Fatalf("environment variable %s is required", "NITELOG_REGISTRATION_DOMAINS")
+		}
+	default:
+		log.Fatalf("unknown registration policy %q", cfg.RegistrationPolicy)
+	}
+
+	for _, role := range cfg.TwoFactorRoles {
+		if !models.IsKnownRole(role) {
+			log.Fatalf("unknown role %q in %s", role, "NITELOG_TWO_FACTOR_ROLES")
+		}
+	}
+
+	return cfg
 }
 
 func mustGetEnv(key string) string {
@@ -36,3 +201,43 @@ func getEnv(key, defaultValue string) string {
 
 	return defaultValue
 }
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("environment variable %s is not a valid duration: %v", key, err)
+	}
+
+	return duration
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("environment variable %s is not a valid integer: %v", key, err)
+	}
+
+	return number
+}
+
+// getEnvList splits a comma separated variable, skipping empty items.
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
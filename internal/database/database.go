@@ -0,0 +1,112 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	DialectSQLite   = "sqlite"
+	DialectPostgres = "postgres"
+)
+
+// DB wraps a *sql.DB with the SQL dialect it speaks, so repositories can
+// write queries with "?" placeholders and run them on any supported engine.
+type DB struct {
+	*sql.DB
+	Dialect string
+}
+
+func Open(dialect, dsn string) (*DB, error) {
+	var driver string
+
+	switch dialect {
+	case DialectSQLite:
+		driver = "sqlite3"
+		dsn = withSQLiteDefaults(dsn)
+	case DialectPostgres:
+		driver = "pgx"
+	default:
+		return nil, fmt.Errorf("unsupported sql dialect %q", dialect)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if dialect == DialectSQLite {
+		// SQLite allows a single writer, serializing connections avoids
+		// "database is locked" errors under concurrent requests.
+		db.SetMaxOpenConns(1)
+	}
+
+	return &DB{DB: db, Dialect: dialect}, nil
+}
+
+// Rebind converts "?" placeholders into the dialect's bind variables.
+func (db *DB) Rebind(query string) string {
+	if db.Dialect != DialectPostgres {
+		return query
+	}
+
+	var builder strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			builder.WriteByte('$')
+			builder.WriteString(strconv.Itoa(n))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// IsUniqueViolation reports whether err was caused by the unique index on
+// table.column. Unique indexes are named "<table>_<column>_key" on every
+// dialect so both engines can be matched by the same pair.
+func IsUniqueViolation(err error, table, column string) bool {
+	if err == nil {
+		return false
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique &&
+			strings.Contains(sqliteErr.Error(), table+"."+column)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23505" && pgErr.ConstraintName == table+"_"+column+"_key"
+	}
+
+	return false
+}
+
+func withSQLiteDefaults(dsn string) string {
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+
+	if !strings.Contains(dsn, "_foreign_keys") && !strings.Contains(dsn, "_fk") {
+		dsn += separator + "_foreign_keys=on"
+		separator = "&"
+	}
+
+	if !strings.Contains(dsn, "_busy_timeout") {
+		dsn += separator + "_busy_timeout=5000"
+	}
+
+	return dsn
+}
@@ -0,0 +1 @@
+ALTER TABLE meetings ADD COLUMN code_secret TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE meetings ADD COLUMN code_rotated_at TIMESTAMPTZ;
+ALTER TABLE meetings ADD COLUMN code_rotated_by TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE attendance ADD COLUMN auto_closed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE attendance ADD COLUMN auto_close_reason TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+CREATE TABLE password_resets (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL DEFAULT '',
+    email TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX password_resets_token_hash_key ON password_resets (token_hash);
+CREATE INDEX password_resets_email_created_at_idx ON password_resets (email, created_at);
+CREATE INDEX password_resets_user_id_idx ON password_resets (user_id);
@@ -0,0 +1,13 @@
+CREATE TABLE invites (
+    code TEXT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    max_uses INTEGER NOT NULL,
+    uses INTEGER NOT NULL DEFAULT 0,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX invites_code_key ON invites (code);
+CREATE INDEX invites_created_at_idx ON invites (created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE two_factor (
+    user_id TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    recovery_codes TEXT NOT NULL DEFAULT '[]',
+    last_counter BIGINT NOT NULL DEFAULT 0,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    enabled_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX two_factor_user_id_key ON two_factor (user_id);
+
+ALTER TABLE sessions ADD COLUMN two_factor BOOLEAN NOT NULL DEFAULT FALSE;
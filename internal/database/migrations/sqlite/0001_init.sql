@@ -0,0 +1,37 @@
+CREATE TABLE users (
+    id TEXT PRIMARY KEY,
+    registration TEXT NOT NULL,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    password_hash TEXT NOT NULL,
+    roles TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX users_email_key ON users (email) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX users_registration_key ON users (registration) WHERE deleted_at IS NULL;
+
+CREATE TABLE meetings (
+    id TEXT PRIMARY KEY,
+    date TIMESTAMP NOT NULL,
+    meeting_code TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX meetings_date_key ON meetings (date) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX meetings_meeting_code_key ON meetings (meeting_code) WHERE deleted_at IS NULL;
+
+CREATE TABLE attendance (
+    meeting_id TEXT NOT NULL REFERENCES meetings (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    registration TEXT NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP,
+    PRIMARY KEY (meeting_id, position)
+);
+
+CREATE INDEX attendance_registration_idx ON attendance (registration);
@@ -0,0 +1,7 @@
+DROP INDEX meetings_date_key;
+CREATE INDEX meetings_date_idx ON meetings (date);
+
+ALTER TABLE meetings ADD COLUMN title TEXT NOT NULL DEFAULT '';
+ALTER TABLE meetings ADD COLUMN location TEXT NOT NULL DEFAULT '';
+ALTER TABLE meetings ADD COLUMN starts_at TIMESTAMP;
+ALTER TABLE meetings ADD COLUMN ends_at TIMESTAMP;
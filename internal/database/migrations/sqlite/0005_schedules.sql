@@ -0,0 +1,17 @@
+CREATE TABLE schedules (
+    id TEXT PRIMARY KEY,
+    title TEXT NOT NULL DEFAULT '',
+    location TEXT NOT NULL DEFAULT '',
+    rule TEXT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP,
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    excluded_dates TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+ALTER TABLE meetings ADD COLUMN schedule_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX meetings_schedule_id_idx ON meetings (schedule_id, date);
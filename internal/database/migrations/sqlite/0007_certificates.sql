@@ -0,0 +1,14 @@
+CREATE TABLE certificates (
+    code TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    registration TEXT NOT NULL,
+    period_from TIMESTAMP NOT NULL,
+    period_to TIMESTAMP NOT NULL,
+    meetings INTEGER NOT NULL,
+    total_seconds BIGINT NOT NULL,
+    issued_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX certificates_code_key ON certificates (code);
+CREATE INDEX certificates_user_id_idx ON certificates (user_id);
@@ -0,0 +1,13 @@
+package admin
+
+import (
+	"nitelog/internal/services/purge"
+)
+
+type AdminController struct {
+	purgeService *services.PurgeService
+}
+
+func NewAdminController(purgeService *services.PurgeService) *AdminController {
+	return &AdminController{purgeService: purgeService}
+}
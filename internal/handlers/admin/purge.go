@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Purge godoc
+// @Summary      Remove definitivamente registros deletados
+// @Description  Remove usuários e reuniões deletados há mais tempo que o período de retenção, anonimizando as presenças e removendo os certificados e a autenticação em dois fatores dos usuários removidos
+// @Tags         admin
+// @Produce      json
+// @Param        dry_run   query     bool   false "Apenas calcula o que seria removido"
+// @Success      200       {object}  services.PurgeResult
+// @Failure      401       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /admin/purge [post]
+func (h *AdminController) Purge(c *gin.Context) {
+	ctx := context.Background()
+
+	result, err := h.purgeService.Purge(ctx, c.Query("dry_run") == "true")
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
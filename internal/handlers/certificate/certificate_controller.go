@@ -0,0 +1,21 @@
+package certificate
+
+import (
+	certificateServices "nitelog/internal/services/certificate"
+	userServices "nitelog/internal/services/user"
+)
+
+type CertificateController struct {
+	certificateService *certificateServices.CertificateService
+	userService        *userServices.UserService
+}
+
+func NewCertificateController(
+	certificateService *certificateServices.CertificateService,
+	userService *userServices.UserService,
+) *CertificateController {
+	return &CertificateController{
+		certificateService: certificateService,
+		userService:        userService,
+	}
+}
@@ -0,0 +1,92 @@
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/models"
+	certificateServices "nitelog/internal/services/certificate"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetUserCertificate godoc
+// @Summary      Emite um certificado de participação
+// @Description  Gera um PDF com as reuniões frequentadas e o total de horas no período, com um código de verificação. Usuários que não são admin só podem emitir o próprio certificado
+// @Tags         user
+// @Produce      application/pdf
+// @Param        id    path      string true  "Id do usuário"
+// @Param        from  query     string false "Data inicial no estilo: 2025-08-01"
+// @Param        to    query     string false "Data final no estilo: 2025-08-31"
+// @Success      200   {file}    file
+// @Failure      400   {object}  util.ErrorResponse
+// @Failure      403   {object}  util.ErrorResponse
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/{id}/certificate [get]
+func (h *CertificateController) GetUserCertificate(c *gin.Context) {
+	id := c.Param("id")
+
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	if principal.UserID != id && !principal.Can(models.PermissionCertificatesIssue) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "cannot issue certificates for other users"})
+		return
+	}
+
+	from, to, err := util.ParseDateRange(c.Query("from"), c.Query("to"))
+	var invalidDate *util.InvalidDateError
+	if errors.As(err, &invalidDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to normalize date",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := context.Background()
+
+	certificate, meetings, err := h.certificateService.Issue(ctx, id, from, to)
+
+	if errors.Is(err, certificateServices.ErrInvalidDateRange) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	if errors.Is(err, certificateServices.ErrNoAttendance) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.certificateService.RenderPDF(&buf, certificate, meetings); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="certificado-`+certificate.Code+`.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
@@ -0,0 +1,39 @@
+package certificate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/services/certificate"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyCertificate godoc
+// @Summary      Verifica um certificado
+// @Description  Confirma a autenticidade de um certificado pelo código impresso nele. Retorna apenas os dados que constam no certificado
+// @Tags         certificate
+// @Produce      json
+// @Param        code  path      string true "Código de verificação"
+// @Success      200   {object}  models.CertificateVerification
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Router       /certificates/verify/{code} [get]
+func (h *CertificateController) VerifyCertificate(c *gin.Context) {
+	ctx := context.Background()
+
+	certificate, err := h.certificateService.Verify(ctx, c.Param("code"))
+
+	if errors.Is(err, services.ErrCertificateNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Certificate not found"})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, certificate.Verification())
+}
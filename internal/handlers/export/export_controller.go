@@ -0,0 +1,48 @@
+package export
+
+import (
+	"net/http"
+
+	"nitelog/internal/services/export"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportController struct {
+	exportService *services.ExportService
+}
+
+func NewExportController(exportService *services.ExportService) *ExportController {
+	return &ExportController{exportService: exportService}
+}
+
+// attachment delays the download headers until the first byte of the file
+// is written, errors found before that are still sent as JSON.
+type attachment struct {
+	c           *gin.Context
+	filename    string
+	contentType string
+	started     bool
+}
+
+func (a *attachment) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.c.Header("Content-Type", a.contentType)
+		a.c.Header("Content-Disposition", `attachment; filename="`+a.filename+`"`)
+		a.c.Status(http.StatusOK)
+	}
+
+	return a.c.Writer.Write(p)
+}
+
+// exportFormat reads the format query parameter, csv by default.
+func exportFormat(c *gin.Context) (string, bool) {
+	format := c.DefaultQuery("format", services.FormatCSV)
+	if format != services.FormatCSV && format != services.FormatXLSX {
+		c.JSON(http.StatusBadRequest, gin.H{"error": services.ErrInvalidFormat.Error()})
+		return "", false
+	}
+
+	return format, true
+}
@@ -0,0 +1,61 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	exportServices "nitelog/internal/services/export"
+	meetingServices "nitelog/internal/services/meeting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportMeeting godoc
+// @Summary      Exporta a lista de presença de uma reunião
+// @Description  Gera uma planilha CSV ou XLSX com nome, matrícula, email, entrada, saída e duração de cada presença, com horários no fuso configurado
+// @Tags         meeting_admin
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        id      path      string true  "Id da reunião"
+// @Param        format  query     string false "csv (padrão) ou xlsx"
+// @Success      200     {file}    file
+// @Failure      400     {object}  util.ErrorResponse
+// @Failure      404     {object}  util.ErrorResponse
+// @Failure      500     {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/{id}/export [get]
+func (h *ExportController) ExportMeeting(c *gin.Context) {
+	format, ok := exportFormat(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	out := &attachment{
+		c:           c,
+		filename:    "presencas-" + id + "." + format,
+		contentType: exportServices.ContentType(format),
+	}
+
+	ctx := context.Background()
+
+	err := h.exportService.Meeting(ctx, id, format, out)
+
+	if err != nil && out.started {
+		log.Printf("export of meeting %s interrupted: %v", id, err)
+		c.Abort()
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"nitelog/internal/services/export"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportMeetings godoc
+// @Summary      Exporta as listas de presença de um período
+// @Description  Gera uma planilha CSV ou XLSX com as presenças de todas as reuniões do período, uma linha por presença, com horários no fuso configurado. O arquivo é enviado à medida que é gerado
+// @Tags         meeting_admin
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        from    query     string false "Data inicial no estilo: 2025-08-01"
+// @Param        to      query     string false "Data final no estilo: 2025-08-31"
+// @Param        format  query     string false "csv (padrão) ou xlsx"
+// @Success      200     {file}    file
+// @Failure      400     {object}  util.ErrorResponse
+// @Failure      500     {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/export [get]
+func (h *ExportController) ExportMeetings(c *gin.Context) {
+	format, ok := exportFormat(c)
+	if !ok {
+		return
+	}
+
+	from, to, err := util.ParseDateRange(c.Query("from"), c.Query("to"))
+	var invalidDate *util.InvalidDateError
+	if errors.As(err, &invalidDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to normalize date",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filename := "presencas"
+	if value := c.Query("from"); value != "" {
+		filename += "-" + value
+	}
+	if value := c.Query("to"); value != "" {
+		filename += "_" + value
+	}
+
+	out := &attachment{
+		c:           c,
+		filename:    filename + "." + format,
+		contentType: services.ContentType(format),
+	}
+
+	ctx := context.Background()
+
+	err = h.exportService.Range(ctx, from, to, format, out)
+
+	if err != nil && out.started {
+		log.Printf("export interrupted: %v", err)
+		c.Abort()
+		return
+	}
+
+	if errors.Is(err, services.ErrInvalidDateRange) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+}
@@ -0,0 +1,59 @@
+package invite
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	inviteServices "nitelog/internal/services/invite"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateInviteRequest struct {
+	Note      string     `json:"note" example:"Turma 2025.2" binding:"max=120"`
+	MaxUses   int        `json:"max_uses" example:"30" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2025-08-08T12:00:00Z"`
+}
+
+// CreateInvite godoc
+// @Summary      Cria um convite
+// @Description  Cria um código de convite para cadastro, com limite de usos e validade. Sem expires_at o convite vale pelo período padrão configurado
+// @Tags         invite
+// @Accept       json
+// @Produce      json
+// @Param        invite  body      CreateInviteRequest  true  "Convite"
+// @Success      201     {object}  models.Invite
+// @Failure      400     {object}  util.ErrorResponse
+// @Failure      500     {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /invites [post]
+func (h *InviteController) CreateInvite(c *gin.Context) {
+	var req CreateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx := context.Background()
+
+	invite, err := h.inviteService.Create(ctx, inviteServices.CreateInviteOptions{
+		Note:      req.Note,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: principal.UserID,
+	})
+	if err != nil {
+		respondInviteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invite)
+}
@@ -0,0 +1,52 @@
+package invite
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetInvites godoc
+// @Summary      Lista convites
+// @Description  Lista todos os convites, dos mais recentes para os mais antigos
+// @Tags         invite
+// @Produce      json
+// @Success      200  {object}  []models.Invite
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /invites [get]
+func (h *InviteController) GetInvites(c *gin.Context) {
+	ctx := context.Background()
+
+	invites, err := h.inviteService.List(ctx)
+	if err != nil {
+		respondInviteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invites)
+}
+
+// GetInviteByCode godoc
+// @Summary      Busca um convite
+// @Description  Retorna o convite com o código informado, incluindo quantas vezes já foi usado
+// @Tags         invite
+// @Produce      json
+// @Param        code  path      string true "Código do convite"
+// @Success      200   {object}  models.Invite
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /invites/{code} [get]
+func (h *InviteController) GetInviteByCode(c *gin.Context) {
+	ctx := context.Background()
+
+	invite, err := h.inviteService.GetByCode(ctx, c.Param("code"))
+	if err != nil {
+		respondInviteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invite)
+}
@@ -0,0 +1,31 @@
+package invite
+
+import (
+	"errors"
+	"net/http"
+
+	inviteServices "nitelog/internal/services/invite"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InviteController struct {
+	inviteService *inviteServices.InviteService
+}
+
+func NewInviteController(inviteService *inviteServices.InviteService) *InviteController {
+	return &InviteController{
+		inviteService: inviteService,
+	}
+}
+
+func respondInviteError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, inviteServices.ErrInviteNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, inviteServices.ErrInvalidExpiration):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
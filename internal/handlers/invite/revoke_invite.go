@@ -0,0 +1,31 @@
+package invite
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RevokeInvite godoc
+// @Summary      Revoga um convite
+// @Description  Impede novos cadastros com o convite. Usuários já cadastrados com ele são mantidos
+// @Tags         invite
+// @Produce      json
+// @Param        code  path      string true "Código do convite"
+// @Success      200   {object}  models.Invite
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /invites/{code} [delete]
+func (h *InviteController) RevokeInvite(c *gin.Context) {
+	ctx := context.Background()
+
+	invite, err := h.inviteService.Revoke(ctx, c.Param("code"))
+	if err != nil {
+		respondInviteError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invite)
+}
@@ -4,24 +4,22 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 
 	meetingServices "nitelog/internal/services/meeting"
 	userServices "nitelog/internal/services/user"
-	"nitelog/internal/util"
 )
 
 type AddUserAttendanceRequest struct {
+	MeetingRefRequest
 	Registration string `firestore:"registration" json:"registration" example:"8854652123" binding:"required"`
-	Date         string `json:"date" example:"2025-10-26" binding:"required"`
 }
 
 // AddUserAttendance godoc
 // @Summary      Registra presença em reunião
-// @Description  Adiciona usuário à lista de presença
-// @Tags         attendance
+// @Description  Adiciona usuário à lista de presença da reunião indicada por id, código ou data (quando houver uma única reunião no dia). Usuários comuns devem usar o check-in por código
+// @Tags         meeting_admin
 // @Accept       json
 // @Produce      json
 // @Param        attendance   body     AddUserAttendanceRequest true "Dados da presença"
@@ -32,7 +30,7 @@ type AddUserAttendanceRequest struct {
 // @Failure      500         {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /meetings/add-attendance [post]
-func AddUserAttendance(c *gin.Context) {
+func (h *MeetingController) AddUserAttendance(c *gin.Context) {
 	var req AddUserAttendanceRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -40,29 +38,23 @@ func AddUserAttendance(c *gin.Context) {
 		return
 	}
 
-	date, err := time.Parse("2006-01-02", req.Date)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format"})
+	ref, err := req.meetingRef()
+	if errors.Is(err, errInvalidDateFormat) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	normalizedDate, err := util.NormalizeDate(date)
-
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Unable to normalize date",
-			"details": err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	ctx := context.Background()
-	meetingService := meetingServices.NewMeetingService()
 
-	_, err = meetingService.GetByDate(ctx, *normalizedDate)
+	_, err = h.userService.GetByRegistration(ctx, req.Registration)
 
-	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 		return
 	}
 
@@ -71,20 +63,23 @@ func AddUserAttendance(c *gin.Context) {
 		return
 	}
 
-	userService := userServices.NewUserService()
-	_, err = userService.GetByRegistration(ctx, req.Registration)
+	err = h.meetingService.AddAttendance(ctx, ref, req.Registration)
 
-	if errors.Is(err, userServices.ErrUserNotFound) {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	if errors.Is(err, meetingServices.ErrMeetingRefRequired) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrAmbiguousMeetingDate) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
 	}
 
-	err = meetingService.AddAttendance(ctx, *normalizedDate, req.Registration)
 	if errors.Is(err, meetingServices.ErrActiveAttendanceExists) {
 		c.JSON(http.StatusConflict, gin.H{"error": "User already in attendance"})
 		return
@@ -0,0 +1,108 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+type CheckInRequest struct {
+	MeetingCode  string `json:"meeting_code" example:"qE522Af8" binding:"required"`
+	Code         string `json:"code" example:"492039"`
+	Registration string `json:"registration,omitempty" example:"8854652123"`
+}
+
+// CheckIn godoc
+// @Summary      Check-in em reunião
+// @Description  Registra a presença do usuário autenticado usando o código da reunião e o código rotativo exibido pelo organizador. Apenas quem gerencia presenças pode informar a matrícula de outro usuário
+// @Tags         attendance
+// @Accept       json
+// @Produce      json
+// @Param        check_in    body      CheckInRequest true "Código da reunião"
+// @Success      200         {object}  util.MessageResponse
+// @Failure      400         {object}  util.ErrorResponse
+// @Failure      403         {object}  util.ErrorResponse
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      409         {object}  util.ErrorResponse
+// @Failure      410         {object}  util.ErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/check-in [post]
+func (h *MeetingController) CheckIn(c *gin.Context) {
+	var req CheckInRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.userService.GetAuthJWTWithUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   "unauthorized",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := context.Background()
+	registration := user.Registration
+
+	if h.requireVerifiedEmail && !user.IsEmailVerified() && (req.Registration == "" || req.Registration == user.Registration) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "email not verified"})
+		return
+	}
+
+	if req.Registration != "" && req.Registration != user.Registration {
+		principal, err := util.GetPrincipal(c)
+		if err != nil || !principal.Can(models.PermissionAttendanceManage) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot check in other user"})
+			return
+		}
+
+		_, err = h.userService.GetByRegistration(ctx, req.Registration)
+		if errors.Is(err, userServices.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		registration = req.Registration
+	}
+
+	_, err = h.meetingService.CheckIn(ctx, req.MeetingCode, req.Code, registration)
+
+	if errors.Is(err, meetingServices.ErrInvalidMeetingCode) ||
+		errors.Is(err, meetingServices.ErrInvalidRotatingCode) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrMeetingCodeExpired) {
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrActiveAttendanceExists) {
+		c.JSON(http.StatusConflict, gin.H{"error": "User already in attendance"})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Check-in completed successfully"})
+}
@@ -16,28 +16,26 @@ import (
 )
 
 type CreateMeetingRequest struct {
-	Date string `json:"date" example:"2025-10-26" binding:"required"`
-}
-
-type DuplicatedMeetingErrorResponse struct {
-	Error           string          `json:"error" example:"Meeting already exists for this date"`
-	ExistingMeeting *models.Meeting `json:"existing_meeting"`
+	Date     string     `json:"date" example:"2025-10-26" binding:"required"`
+	Title    string     `json:"title" example:"Reunião noturna" binding:"max=120"`
+	Location string     `json:"location" example:"Laboratório 3" binding:"max=120"`
+	StartsAt *time.Time `json:"starts_at" example:"2025-10-26T19:00:00-03:00"`
+	EndsAt   *time.Time `json:"ends_at" example:"2025-10-26T22:00:00-03:00"`
 }
 
 // CreateMeeting godoc
 // @Summary      Cria uma nova reunião
-// @Description  Registra uma nova reunião com código único
+// @Description  Registra uma nova reunião com código único. Um mesmo dia pode ter várias reuniões, cada uma com título, local e horário próprios
 // @Tags         meeting
 // @Accept       json
 // @Produce      json
 // @Param        meeting  body      CreateMeetingRequest  true  "Data da Reunião"
 // @Success      201      {object}  models.Meeting
-// @Failure      400      {object}  util.ErrorResponse
-// @Failure      409      {object}  DuplicatedMeetingErrorResponse
+// @Failure      400      {object}  util.ValidationErrorResponse
 // @Failure      500      {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /meetings [post]
-func CreateMeeting(c *gin.Context) {
+func (h *MeetingController) CreateMeeting(c *gin.Context) {
 	var req CreateMeetingRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -67,15 +65,19 @@ func CreateMeeting(c *gin.Context) {
 
 	ctx := context.Background()
 
-	meetingService := services.NewMeetingService()
-	meeting, err := meetingService.Create(ctx, *normalizedDate)
-
-	if errors.Is(err, services.ErrDuplicateMeeting) {
-		res := DuplicatedMeetingErrorResponse{
-			Error: "Meeting already exists for this date",
-		}
-
-		c.JSON(http.StatusConflict, res)
+	meeting, err := h.meetingService.Create(ctx, models.Meeting{
+		Date:     *normalizedDate,
+		Title:    req.Title,
+		Location: req.Location,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	})
+
+	if fields := timeSlotErrorFields(err); fields != nil {
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: fields,
+		})
 		return
 	}
 
@@ -90,3 +92,16 @@ func CreateMeeting(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, meeting)
 }
+
+// timeSlotErrorFields maps the time slot validation errors of the service to
+// the request fields they refer to, nil means err is not one of them.
+func timeSlotErrorFields(err error) map[string]string {
+	switch {
+	case errors.Is(err, services.ErrTimeSlotOutsideDate):
+		return map[string]string{"starts_at": err.Error()}
+	case errors.Is(err, services.ErrInvalidTimeSlot):
+		return map[string]string{"ends_at": err.Error()}
+	default:
+		return nil
+	}
+}
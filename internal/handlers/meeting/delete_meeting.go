@@ -5,8 +5,6 @@ import (
 	"errors"
 	"net/http"
 	meetingServices "nitelog/internal/services/meeting"
-	userServices "nitelog/internal/services/user"
-	"slices"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,32 +22,12 @@ import (
 // @Failure      500         {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /meetings/delete/:id [delete]
-func DeleteMeeting(c *gin.Context) {
-	userID, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
-		return
-	}
+func (h *MeetingController) DeleteMeeting(c *gin.Context) {
+	id := c.Param("id")
 
 	ctx := context.Background()
 
-	userService := userServices.NewUserService()
-	user, err := userService.GetByID(ctx, userID.(string))
-
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if !slices.Contains(user.Roles, "admin") {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized, user is not admin"})
-		return
-	}
-
-	id := c.Param("id")
-
-	meetingService := meetingServices.NewMeetingService()
-	err = meetingService.SoftDelete(ctx, id)
+	err := h.meetingService.SoftDelete(ctx, id)
 
 	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
@@ -4,33 +4,36 @@ import (
 	"context"
 	"errors"
 	"net/http"
+
+	"nitelog/internal/models"
 	meetingServices "nitelog/internal/services/meeting"
 	userServices "nitelog/internal/services/user"
 	"nitelog/internal/util"
-	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type FinishUserAttendanceRequest struct {
-	Registration string `firestore:"registration" json:"registration" example:"8854652123" binding:"required"`
-	Date         string `json:"date" example:"2025-10-26" binding:"required"`
+	MeetingRefRequest
+	Registration string `firestore:"registration" json:"registration,omitempty" example:"8854652123"`
 }
 
 // FinishUserAttendance godoc
 // @Summary      Finaliza presença em reunião
-// @Description  Finaliza a presença usuário do usuário
+// @Description  Finaliza a presença do usuário autenticado na reunião indicada por id, código ou data (quando houver uma única reunião no dia). Apenas quem gerencia presenças pode informar a matrícula de outro usuário
 // @Tags         attendance
 // @Accept       json
 // @Produce      json
 // @Param        attendance     body   FinishUserAttendanceRequest true "Dados da presença"
 // @Success      200         {object}  util.MessageResponse
 // @Failure      400         {object}  util.ErrorResponse
+// @Failure      403         {object}  util.ErrorResponse
 // @Failure      404         {object}  util.ErrorResponse
+// @Failure      409         {object}  util.ErrorResponse
 // @Failure      500         {object}  util.ErrorResponse
-// @securityDefinitions.apikey  BearerAuth
+// @Security     BearerAuth
 // @Router       /meetings/finish-attendance [post]
-func FinishUserAttendance(c *gin.Context) {
+func (h *MeetingController) FinishUserAttendance(c *gin.Context) {
 	var req FinishUserAttendanceRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -38,37 +41,59 @@ func FinishUserAttendance(c *gin.Context) {
 		return
 	}
 
-	date, err := time.Parse("2006-01-02", req.Date)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format"})
+	ref, err := req.meetingRef()
+	if errors.Is(err, errInvalidDateFormat) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	normalizedDate, err := util.NormalizeDate(date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
+	user, err := h.userService.GetAuthJWTWithUser(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Unable to normalize date",
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   "unauthorized",
 			"details": err.Error(),
 		})
 		return
 	}
 
 	ctx := context.Background()
+	registration := user.Registration
+
+	if req.Registration != "" && req.Registration != user.Registration {
+		principal, err := util.GetPrincipal(c)
+		if err != nil || !principal.Can(models.PermissionAttendanceManage) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot finish attendance of other user"})
+			return
+		}
+
+		_, err = h.userService.GetByRegistration(ctx, req.Registration)
+		if errors.Is(err, userServices.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		registration = req.Registration
+	}
 
-	userService := userServices.NewUserService()
-	_, err = userService.GetByRegistration(ctx, req.Registration)
+	err = h.meetingService.FinishAttendance(ctx, ref, registration)
 
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "User not found",
+	if errors.Is(err, meetingServices.ErrMeetingRefRequired) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
 		})
 		return
 	}
 
-	meetingService := meetingServices.NewMeetingService()
-	err = meetingService.FinishAttendance(ctx, *normalizedDate, req.Registration)
-
 	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": err.Error(),
@@ -76,6 +101,13 @@ func FinishUserAttendance(c *gin.Context) {
 		return
 	}
 
+	if errors.Is(err, meetingServices.ErrAmbiguousMeetingDate) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
 	if errors.Is(err, meetingServices.ErrNoAttendanceToFinish) {
 		c.JSON(http.StatusNotAcceptable, gin.H{
 			"error": err.Error(),
@@ -6,15 +6,23 @@ import (
 	"net/http"
 	"time"
 
+	"nitelog/internal/models"
 	"nitelog/internal/services/meeting"
 	"nitelog/internal/util"
 
 	"github.com/gin-gonic/gin"
 )
 
+// AmbiguousMeetingDateResponse lists the meetings of a day with more than
+// one, so clients can pick one by id.
+type AmbiguousMeetingDateResponse struct {
+	Error    string                  `json:"error" example:"more than one meeting on this date, use the meeting id or code"`
+	Meetings []models.MeetingSummary `json:"meetings"`
+}
+
 // GetMeetingByDate godoc
 // @Summary      Procura reunião por data
-// @Description  Procura reunião por data especifica
+// @Description  Procura a reunião de uma data especifica. Quando o dia tem mais de uma reunião retorna 409 com o resumo de cada uma. O código da reunião só é exibido para quem gerencia presenças
 // @Tags         meeting
 // @Accept       json
 // @Produce      json
@@ -22,10 +30,11 @@ import (
 // @Success      200         {object}  models.Meeting
 // @Failure      400         {object}  util.ErrorResponse
 // @Failure      404         {object}  util.ErrorResponse
+// @Failure      409         {object}  AmbiguousMeetingDateResponse
 // @Failure      500         {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /meetings/by-date/:date [get]
-func GetMeetingByDate(c *gin.Context) {
+func (h *MeetingController) GetMeetingByDate(c *gin.Context) {
 	dateParam := c.Param("date")
 
 	date, err := time.Parse("2006-01-02", dateParam)
@@ -46,18 +55,44 @@ func GetMeetingByDate(c *gin.Context) {
 
 	ctx := context.Background()
 
-	meetingService := services.NewMeetingService()
-	meeting, err := meetingService.GetByDate(ctx, *normalizedDate)
+	meeting, err := h.meetingService.GetByDate(ctx, *normalizedDate)
 
 	if errors.Is(err, services.ErrMeetingNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "No meeting for this date"})
 		return
 	}
 
+	if errors.Is(err, services.ErrAmbiguousMeetingDate) {
+		meetings, err := h.meetingService.ListByDate(ctx, *normalizedDate)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		res := AmbiguousMeetingDateResponse{
+			Error:    services.ErrAmbiguousMeetingDate.Error(),
+			Meetings: make([]models.MeetingSummary, 0, len(meetings)),
+		}
+		canSeeCodes := h.canSeeMeetingCodes(c)
+		for _, meeting := range meetings {
+			if !canSeeCodes {
+				meeting.MeetingCode = ""
+			}
+			res.Meetings = append(res.Meetings, meeting.Summary())
+		}
+
+		c.JSON(http.StatusConflict, res)
+		return
+	}
+
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if !h.canSeeMeetingCodes(c) {
+		meeting.MeetingCode = ""
+	}
+
 	c.JSON(http.StatusOK, meeting)
 }
@@ -12,7 +12,7 @@ import (
 
 // GetMeetingByID godoc
 // @Summary      Procura reunião por id
-// @Description  Procura reunião por id especifico
+// @Description  Procura reunião por id especifico. O código da reunião só é exibido para quem gerencia presenças
 // @Tags         meeting
 // @Accept       json
 // @Produce      json
@@ -23,13 +23,12 @@ import (
 // @Failure      500         {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /meetings/:id [get]
-func GetMeetingByID(c *gin.Context) {
+func (h *MeetingController) GetMeetingByID(c *gin.Context) {
 	id := c.Param("id")
 
 	ctx := context.Background()
 
-	meetingService := services.NewMeetingService()
-	meeting, err := meetingService.GetByID(ctx, id)
+	meeting, err := h.meetingService.GetByID(ctx, id)
 
 	if errors.Is(err, services.ErrMeetingNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "No meeting for this date"})
@@ -41,5 +40,9 @@ func GetMeetingByID(c *gin.Context) {
 		return
 	}
 
+	if !h.canSeeMeetingCodes(c) {
+		meeting.MeetingCode = ""
+	}
+
 	c.JSON(http.StatusOK, meeting)
 }
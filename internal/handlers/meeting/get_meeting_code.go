@@ -0,0 +1,43 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/services/meeting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetMeetingCode godoc
+// @Summary      Código atual da reunião
+// @Description  Retorna o código rotativo atual para ser exibido na tela do organizador
+// @Tags         meeting_admin
+// @Produce      json
+// @Param        meeting_id   path     string true "ID da reunião"
+// @Success      200         {object}  services.RotatingCode
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/:id/code [get]
+func (h *MeetingController) GetMeetingCode(c *gin.Context) {
+	id := c.Param("id")
+
+	ctx := context.Background()
+
+	code, err := h.meetingService.CurrentCode(ctx, id)
+
+	if errors.Is(err, services.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, code)
+}
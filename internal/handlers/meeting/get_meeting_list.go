@@ -0,0 +1,102 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"nitelog/internal/models"
+	"nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetMeetings godoc
+// @Summary      Lista reuniões
+// @Description  Lista reuniões ordenadas por data, paginadas por cursor. Com summary=true as listas de presença são substituídas pela contagem. O código da reunião só é exibido para quem gerencia presenças
+// @Tags         meeting
+// @Produce      json
+// @Param        from             query     string false "Data inicial no estilo: 2024-10-26"
+// @Param        to               query     string false "Data final no estilo: 2024-12-20"
+// @Param        include_deleted  query     bool   false "Inclui reuniões deletadas (apenas admin)"
+// @Param        summary          query     bool   false "Omite as listas de presença"
+// @Param        limit            query     int    false "Tamanho da página (máximo 200)"
+// @Param        cursor           query     string false "Cursor retornado pela página anterior"
+// @Success      200              {object}  services.MeetingPage
+// @Failure      400              {object}  util.ErrorResponse
+// @Failure      403              {object}  util.ErrorResponse
+// @Failure      500              {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings [get]
+func (h *MeetingController) GetMeetings(c *gin.Context) {
+	opts := services.MeetingListOptions{
+		IncludeDeleted: c.Query("include_deleted") == "true",
+		Cursor:         c.Query("cursor"),
+	}
+
+	var err error
+	opts.From, opts.To, err = util.ParseDateRange(c.Query("from"), c.Query("to"))
+	var invalidDate *util.InvalidDateError
+	if errors.As(err, &invalidDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to normalize date",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if opts.From != nil && opts.To != nil && opts.To.Before(*opts.From) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must not be before from"})
+		return
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		opts.Limit = limit
+	}
+
+	if opts.IncludeDeleted {
+		principal, err := util.GetPrincipal(c)
+		if err != nil || !principal.Can(models.PermissionMeetingsWrite) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot list deleted meetings"})
+			return
+		}
+	}
+
+	ctx := context.Background()
+
+	page, err := h.meetingService.List(ctx, opts)
+
+	if errors.Is(err, services.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !h.canSeeMeetingCodes(c) {
+		for i := range page.Meetings {
+			page.Meetings[i].MeetingCode = ""
+		}
+	}
+
+	if c.Query("summary") == "true" {
+		c.JSON(http.StatusOK, page.Summary())
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
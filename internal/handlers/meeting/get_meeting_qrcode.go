@@ -0,0 +1,101 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	minQRCodeSize     = 64
+	maxQRCodeSize     = 2048
+	defaultQRCodeSize = 256
+)
+
+// GetMeetingQRCode godoc
+// @Summary      QR code de check-in
+// @Description  Gera um QR code (PNG ou SVG) com o link de check-in da reunião. Para reuniões com código rotativo o QR code expira junto com o código
+// @Tags         meeting_admin
+// @Produce      png
+// @Produce      image/svg+xml
+// @Param        meeting_id  path      string true  "ID da reunião"
+// @Param        format      query     string false "png ou svg" Enums(png, svg)
+// @Param        size        query     int    false "Tamanho em pixels (64 a 2048)"
+// @Param        level       query     string false "Correção de erro" Enums(L, M, Q, H)
+// @Success      200         {file}    binary
+// @Failure      400         {object}  util.ErrorResponse
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/:id/qrcode [get]
+func (h *MeetingController) GetMeetingQRCode(c *gin.Context) {
+	format := c.DefaultQuery("format", "png")
+	if format != "png" && format != "svg" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, expected png or svg"})
+		return
+	}
+
+	size, err := strconv.Atoi(c.DefaultQuery("size", strconv.Itoa(defaultQRCodeSize)))
+	if err != nil || size < minQRCodeSize || size > maxQRCodeSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid size",
+			"details": "size must be an integer between 64 and 2048",
+		})
+		return
+	}
+
+	level, err := util.ParseQRCodeLevel(c.Query("level"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	link, code, err := h.meetingService.CheckInLink(ctx, c.Param("id"))
+
+	if errors.Is(err, services.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var (
+		image       []byte
+		contentType string
+	)
+
+	if format == "svg" {
+		image, err = util.RenderQRCodeSVG(link, level, size)
+		contentType = "image/svg+xml"
+	} else {
+		image, err = util.RenderQRCodePNG(link, level, size)
+		contentType = "image/png"
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to render QR code",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if code.ExpiresAt != nil {
+		c.Header("X-Code-Expires-At", code.ExpiresAt.Format(time.RFC3339))
+	}
+
+	c.Data(http.StatusOK, contentType, image)
+}
@@ -0,0 +1,38 @@
+package meeting
+
+import (
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MeetingController struct {
+	meetingService *meetingServices.MeetingService
+	userService    *userServices.UserService
+
+	// requireVerifiedEmail refuses check-ins of users who did not verify
+	// their email yet.
+	requireVerifiedEmail bool
+}
+
+func NewMeetingController(
+	meetingService *meetingServices.MeetingService,
+	userService *userServices.UserService,
+	requireVerifiedEmail bool,
+) *MeetingController {
+	return &MeetingController{
+		meetingService:       meetingService,
+		userService:          userService,
+		requireVerifiedEmail: requireVerifiedEmail,
+	}
+}
+
+// canSeeMeetingCodes reports whether the caller may read meeting codes,
+// members have to read the code in the room to check in.
+func (h *MeetingController) canSeeMeetingCodes(c *gin.Context) bool {
+	principal, err := util.GetPrincipal(c)
+	return err == nil && principal.Can(models.PermissionAttendanceManage)
+}
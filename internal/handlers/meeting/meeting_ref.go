@@ -0,0 +1,40 @@
+package meeting
+
+import (
+	"errors"
+	"fmt"
+
+	"nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+)
+
+var errInvalidDateFormat = errors.New("Invalid date format")
+
+// MeetingRefRequest selects the meeting of an attendance operation. Only one
+// field is needed, date only works on days with a single meeting.
+type MeetingRefRequest struct {
+	MeetingID   string `json:"meeting_id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1"`
+	MeetingCode string `json:"meeting_code,omitempty" example:"qE522Af8"`
+	Date        string `json:"date,omitempty" example:"2025-10-26"`
+}
+
+func (r *MeetingRefRequest) meetingRef() (services.MeetingRef, error) {
+	ref := services.MeetingRef{ID: r.MeetingID, Code: r.MeetingCode}
+	if r.Date == "" {
+		return ref, nil
+	}
+
+	date, err := util.ParseDate(r.Date)
+	if err != nil {
+		return ref, errInvalidDateFormat
+	}
+
+	normalizedDate, err := util.NormalizeDate(date)
+	if err != nil {
+		return ref, fmt.Errorf("Unable to normalize date: %w", err)
+	}
+
+	ref.Date = normalizedDate
+
+	return ref, nil
+}
@@ -0,0 +1,77 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetDeletedMeetings godoc
+// @Summary      Lista reuniões deletadas
+// @Description  Retorna as reuniões removidas que ainda podem ser restauradas
+// @Tags         meeting_admin
+// @Produce      json
+// @Success      200         {object}  []models.Meeting
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/deleted [get]
+func (h *MeetingController) GetDeletedMeetings(c *gin.Context) {
+	ctx := context.Background()
+
+	meetings, err := h.meetingService.ListDeleted(ctx)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, meetings)
+}
+
+// RestoreMeeting godoc
+// @Summary      Restaura uma reunião
+// @Description  Restaura uma reunião deletada, falhando caso a data ou o código já estejam em uso
+// @Tags         meeting_admin
+// @Produce      json
+// @Param        meeting_id  path      string true "ID da reunião"
+// @Success      200         {object}  models.Meeting
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      409         {object}  util.ValidationErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/:id/restore [post]
+func (h *MeetingController) RestoreMeeting(c *gin.Context) {
+	ctx := context.Background()
+
+	meeting, err := h.meetingService.Restore(ctx, c.Param("id"))
+
+	if errors.Is(err, services.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Deleted meeting not found"})
+		return
+	}
+
+	fields := make(map[string]string)
+	if errors.Is(err, services.ErrMeetingCodeTaken) {
+		fields["meeting_code"] = services.ErrMeetingCodeTaken.Error()
+	}
+
+	if len(fields) > 0 {
+		c.JSON(http.StatusConflict, util.ValidationErrorResponse{
+			Error:  "meeting cannot be restored",
+			Fields: fields,
+		})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, meeting)
+}
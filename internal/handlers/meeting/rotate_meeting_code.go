@@ -0,0 +1,48 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RotateMeetingCode godoc
+// @Summary      Gera um novo código para a reunião
+// @Description  Substitui o código da reunião e o segredo do código rotativo, invalidando o código anterior imediatamente
+// @Tags         meeting_admin
+// @Produce      json
+// @Param        meeting_id  path      string true "ID da reunião"
+// @Success      200         {object}  models.Meeting
+// @Failure      401         {object}  util.ErrorResponse
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/:id/rotate-code [post]
+func (h *MeetingController) RotateMeetingCode(c *gin.Context) {
+	userID, err := util.GetAuthJWT(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx := context.Background()
+
+	meeting, err := h.meetingService.RotateCode(ctx, c.Param("id"), userID)
+
+	if errors.Is(err, services.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, meeting)
+}
@@ -0,0 +1,196 @@
+package meeting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+var meetingCodePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{4,32}$`)
+
+type UpdateMeetingRequest struct {
+	Date        *string             `json:"date,omitempty" example:"2025-10-26"`
+	Title       *string             `json:"title,omitempty" example:"Reunião noturna"`
+	Location    *string             `json:"location,omitempty" example:"Laboratório 3"`
+	StartsAt    *time.Time          `json:"starts_at,omitempty" example:"2025-10-26T19:00:00-03:00"`
+	EndsAt      *time.Time          `json:"ends_at,omitempty" example:"2025-10-26T22:00:00-03:00"`
+	MeetingCode *string             `json:"meeting_code,omitempty" example:"qE522Af8"`
+	Attendance  []models.Attendance `json:"attendance,omitempty"`
+}
+
+// UpdateMeeting godoc
+// @Summary      Atualiza uma reunião
+// @Description  Atualiza data, título, local, horário, código e lista de presença de uma reunião. Apenas os campos enviados são alterados e mudar a data move o horário junto
+// @Tags         meeting_admin
+// @Accept       json
+// @Produce      json
+// @Param        meeting_id  path      string               true "ID da reunião"
+// @Param        meeting     body      UpdateMeetingRequest true "Campos a serem alterados"
+// @Success      200         {object}  models.Meeting
+// @Failure      400         {object}  util.ValidationErrorResponse
+// @Failure      404         {object}  util.ErrorResponse
+// @Failure      409         {object}  util.ValidationErrorResponse
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /meetings/:id [patch]
+func (h *MeetingController) UpdateMeeting(c *gin.Context) {
+	var req UpdateMeetingRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	fields := make(map[string]string)
+	var updatedMeeting models.Meeting
+
+	if req.Date != nil {
+		date, err := util.ParseDate(*req.Date)
+		if err != nil {
+			fields["date"] = fmt.Sprintf("invalid date format, example: 2025-04-02, received: %s", *req.Date)
+		} else {
+			normalizedDate, err := util.NormalizeDate(date)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"error":   "Unable to normalize date",
+					"details": err.Error(),
+				})
+				return
+			}
+			updatedMeeting.Date = *normalizedDate
+		}
+	}
+
+	if req.Title != nil {
+		if title := strings.TrimSpace(*req.Title); title == "" || len(title) > 120 {
+			fields["title"] = "must have 1 to 120 characters"
+		} else {
+			updatedMeeting.Title = title
+		}
+	}
+
+	if req.Location != nil {
+		if location := strings.TrimSpace(*req.Location); location == "" || len(location) > 120 {
+			fields["location"] = "must have 1 to 120 characters"
+		} else {
+			updatedMeeting.Location = location
+		}
+	}
+
+	updatedMeeting.StartsAt = req.StartsAt
+	updatedMeeting.EndsAt = req.EndsAt
+
+	if req.MeetingCode != nil {
+		if !meetingCodePattern.MatchString(*req.MeetingCode) {
+			fields["meeting_code"] = "must have 4 to 32 letters, digits, '-' or '_'"
+		} else {
+			updatedMeeting.MeetingCode = *req.MeetingCode
+		}
+	}
+
+	if req.Attendance != nil {
+		if err := h.validateAttendance(ctx, req.Attendance, fields); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		updatedMeeting.Attendance = req.Attendance
+	}
+
+	if len(fields) > 0 {
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: fields,
+		})
+		return
+	}
+
+	meeting, err := h.meetingService.Update(ctx, c.Param("id"), updatedMeeting)
+
+	if errors.Is(err, meetingServices.ErrMeetingNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrNoChangesDetected) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, meetingServices.ErrMeetingCodeTaken) {
+		c.JSON(http.StatusConflict, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: map[string]string{"meeting_code": err.Error()},
+		})
+		return
+	}
+
+	if fields := timeSlotErrorFields(err); fields != nil {
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: fields,
+		})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error updating meeting",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, meeting)
+}
+
+// validateAttendance records a field error for every invalid entry, keyed
+// as attendance[i].field. Only unexpected lookup failures are returned.
+func (h *MeetingController) validateAttendance(ctx context.Context, attendance []models.Attendance, fields map[string]string) error {
+	seen := make(map[string]bool)
+
+	for i, item := range attendance {
+		prefix := fmt.Sprintf("attendance[%d].", i)
+
+		if item.StartTime.IsZero() {
+			fields[prefix+"start_time"] = "is required"
+		}
+
+		if item.EndTime != nil && item.EndTime.Before(item.StartTime) {
+			fields[prefix+"end_time"] = "must not be before start_time"
+		}
+
+		if item.Registration == "" {
+			fields[prefix+"registration"] = "is required"
+			continue
+		}
+
+		if seen[item.Registration] {
+			fields[prefix+"registration"] = "duplicated registration"
+			continue
+		}
+		seen[item.Registration] = true
+
+		_, err := h.userService.GetByRegistration(ctx, item.Registration)
+		if errors.Is(err, userServices.ErrUserNotFound) {
+			fields[prefix+"registration"] = "user not found"
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	passwordServices "nitelog/internal/services/password"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" example:"sample@email.com" binding:"required"`
+}
+
+// ForgotPassword godoc
+// @Summary      Solicita a redefinição de senha
+// @Description  Envia por email um link de uso único para redefinir a senha. A resposta é a mesma para emails cadastrados ou não
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ForgotPasswordRequest  true  "Email da conta"
+// @Success      202      {object}  util.MessageResponse
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      429      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Router       /users/password/forgot [post]
+func (h *PasswordController) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	err := h.passwordService.Forgot(ctx, req.Email)
+
+	if errors.Is(err, passwordServices.ErrTooManyResetRequests) {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "If the email is registered, a reset link has been sent to it",
+	})
+}
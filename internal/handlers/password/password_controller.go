@@ -0,0 +1,15 @@
+package password
+
+import (
+	passwordServices "nitelog/internal/services/password"
+)
+
+type PasswordController struct {
+	passwordService *passwordServices.PasswordService
+}
+
+func NewPasswordController(passwordService *passwordServices.PasswordService) *PasswordController {
+	return &PasswordController{
+		passwordService: passwordService,
+	}
+}
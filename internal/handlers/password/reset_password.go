@@ -0,0 +1,51 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	passwordServices "nitelog/internal/services/password"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" example:"mC4bq0u8H1m0c2dJ9xVqv3rS6yTn2wKf7pLz5aEoQeI" binding:"required"`
+	Password string `json:"password" example:"safePassword123#" binding:"required"`
+}
+
+// ResetPassword godoc
+// @Summary      Redefine a senha
+// @Description  Define uma nova senha usando o token recebido por email. O token só vale uma vez e todas as sessões do usuário são encerradas
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ResetPasswordRequest  true  "Token e nova senha"
+// @Success      200      {object}  util.MessageResponse
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Router       /users/password/reset [post]
+func (h *PasswordController) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	err := h.passwordService.Reset(ctx, req.Token, req.Password)
+
+	if errors.Is(err, passwordServices.ErrInvalidResetToken) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
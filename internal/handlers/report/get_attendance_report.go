@@ -0,0 +1,91 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/models"
+	reportServices "nitelog/internal/services/report"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAttendanceReport godoc
+// @Summary      Relatório de horas de presença
+// @Description  Soma por usuário as reuniões frequentadas, a duração total e média das presenças, a primeira e a última presença e as faltas em relação às reuniões realizadas no período. Usuários que não são admin só podem consultar a própria matrícula
+// @Tags         report
+// @Produce      json
+// @Param        from          query     string false "Data inicial no estilo: 2025-08-01"
+// @Param        to            query     string false "Data final no estilo: 2025-08-31"
+// @Param        registration  query     string false "Matrícula do usuário"
+// @Success      200           {object}  services.AttendanceReport
+// @Failure      400           {object}  util.ErrorResponse
+// @Failure      403           {object}  util.ErrorResponse
+// @Failure      404           {object}  util.ErrorResponse
+// @Failure      500           {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/attendance [get]
+func (h *ReportController) GetAttendanceReport(c *gin.Context) {
+	opts := reportServices.AttendanceReportOptions{
+		Registration: c.Query("registration"),
+	}
+
+	var err error
+	opts.From, opts.To, err = util.ParseDateRange(c.Query("from"), c.Query("to"))
+	var invalidDate *util.InvalidDateError
+	if errors.As(err, &invalidDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to normalize date",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	if !principal.Can(models.PermissionReportsRead) {
+		user, err := h.userService.GetAuthJWTWithUser(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		if opts.Registration != "" && opts.Registration != user.Registration {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot see the report of other users"})
+			return
+		}
+		opts.Registration = user.Registration
+	}
+
+	ctx := context.Background()
+
+	report, err := h.reportService.Attendance(ctx, opts)
+
+	if errors.Is(err, reportServices.ErrInvalidDateRange) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,21 @@
+package report
+
+import (
+	reportServices "nitelog/internal/services/report"
+	userServices "nitelog/internal/services/user"
+)
+
+type ReportController struct {
+	reportService *reportServices.ReportService
+	userService   *userServices.UserService
+}
+
+func NewReportController(
+	reportService *reportServices.ReportService,
+	userService *userServices.UserService,
+) *ReportController {
+	return &ReportController{
+		reportService: reportService,
+		userService:   userService,
+	}
+}
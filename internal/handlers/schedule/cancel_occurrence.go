@@ -0,0 +1,53 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CancelOccurrenceRequest struct {
+	Date string `json:"date" example:"2025-08-07" binding:"required"`
+}
+
+// CancelOccurrence godoc
+// @Summary      Cancela uma ocorrência da agenda
+// @Description  Exclui uma data da agenda e deleta a reunião já criada para ela, que pode ser restaurada manualmente
+// @Tags         schedule
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                   true "Id da agenda"
+// @Param        date  body      CancelOccurrenceRequest  true "Data da ocorrência"
+// @Success      200   {object}  services.Occurrence
+// @Failure      400   {object}  util.ErrorResponse
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      409   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules/{id}/cancel [post]
+func (h *ScheduleController) CancelOccurrence(c *gin.Context) {
+	var req CancelOccurrenceRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	date, err := parseDate(req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	occurrence, err := h.scheduleService.CancelOccurrence(ctx, c.Param("id"), *date)
+
+	if err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, occurrence)
+}
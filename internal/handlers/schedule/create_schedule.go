@@ -0,0 +1,90 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateScheduleRequest struct {
+	Title     string `json:"title" example:"Reunião semanal" binding:"required,max=120"`
+	Location  string `json:"location" example:"Laboratório 3" binding:"max=120"`
+	Rule      string `json:"rule" example:"FREQ=WEEKLY;BYDAY=TU,TH" binding:"required"`
+	StartDate string `json:"start_date" example:"2025-08-05" binding:"required"`
+	EndDate   string `json:"end_date" example:"2025-12-16"`
+	StartTime string `json:"start_time" example:"19:00" binding:"required"`
+	EndTime   string `json:"end_time" example:"22:00" binding:"required"`
+}
+
+// schedule converts the request into a schedule, reporting which date field
+// could not be parsed.
+func (req *CreateScheduleRequest) schedule() (*models.Schedule, map[string]string) {
+	startDate, err := parseDate(req.StartDate)
+	if err != nil {
+		return nil, map[string]string{"start_date": err.Error()}
+	}
+
+	schedule := &models.Schedule{
+		Title:     req.Title,
+		Location:  req.Location,
+		Rule:      req.Rule,
+		StartDate: *startDate,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+
+	if req.EndDate != "" {
+		endDate, err := parseDate(req.EndDate)
+		if err != nil {
+			return nil, map[string]string{"end_date": err.Error()}
+		}
+		schedule.EndDate = endDate
+	}
+
+	return schedule, nil
+}
+
+// CreateSchedule godoc
+// @Summary      Cria uma agenda recorrente
+// @Description  Cria uma agenda que gera reuniões automaticamente a partir de uma regra de recorrência (FREQ=DAILY|WEEKLY, INTERVAL, BYDAY, COUNT, UNTIL). As reuniões são criadas com antecedência dentro do horizonte configurado
+// @Tags         schedule
+// @Accept       json
+// @Produce      json
+// @Param        schedule  body      CreateScheduleRequest  true  "Agenda"
+// @Success      201       {object}  models.Schedule
+// @Failure      400       {object}  util.ValidationErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules [post]
+func (h *ScheduleController) CreateSchedule(c *gin.Context) {
+	var req CreateScheduleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	schedule, fields := req.schedule()
+	if fields != nil {
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: fields,
+		})
+		return
+	}
+
+	ctx := context.Background()
+
+	created, err := h.scheduleService.Create(ctx, *schedule)
+
+	if err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
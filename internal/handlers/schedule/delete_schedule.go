@@ -0,0 +1,30 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteSchedule godoc
+// @Summary      Deleta uma agenda
+// @Description  Interrompe a criação de reuniões da agenda. Reuniões futuras sem presenças são deletadas, as passadas são mantidas
+// @Tags         schedule
+// @Produce      json
+// @Param        id   path      string true "Id da agenda"
+// @Success      200  {object}  util.MessageResponse
+// @Failure      404  {object}  util.ErrorResponse
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules/{id} [delete]
+func (h *ScheduleController) DeleteSchedule(c *gin.Context) {
+	ctx := context.Background()
+
+	if err := h.scheduleService.Delete(ctx, c.Param("id")); err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Schedule deleted successfully"})
+}
@@ -0,0 +1,32 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetScheduleByID godoc
+// @Summary      Busca uma agenda
+// @Description  Retorna uma agenda recorrente pelo id
+// @Tags         schedule
+// @Produce      json
+// @Param        id   path      string true "Id da agenda"
+// @Success      200  {object}  models.Schedule
+// @Failure      404  {object}  util.ErrorResponse
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules/{id} [get]
+func (h *ScheduleController) GetScheduleByID(c *gin.Context) {
+	ctx := context.Background()
+
+	schedule, err := h.scheduleService.GetByID(ctx, c.Param("id"))
+
+	if err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
@@ -0,0 +1,30 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSchedules godoc
+// @Summary      Lista agendas
+// @Description  Lista as agendas recorrentes ativas ordenadas por título
+// @Tags         schedule
+// @Produce      json
+// @Success      200  {array}   models.Schedule
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules [get]
+func (h *ScheduleController) GetSchedules(c *gin.Context) {
+	ctx := context.Background()
+
+	schedules, err := h.scheduleService.List(ctx)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, schedules)
+}
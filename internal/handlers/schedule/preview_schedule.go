@@ -0,0 +1,111 @@
+package schedule
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PreviewScheduleRequest struct {
+	CreateScheduleRequest
+	From string `json:"from" example:"2025-08-01" binding:"required"`
+	To   string `json:"to" example:"2025-08-31" binding:"required"`
+}
+
+// PreviewSchedule godoc
+// @Summary      Pré-visualiza uma agenda
+// @Description  Lista as ocorrências que uma agenda ainda não salva teria no intervalo informado (máximo 366 dias)
+// @Tags         schedule
+// @Accept       json
+// @Produce      json
+// @Param        schedule  body      PreviewScheduleRequest  true  "Agenda e intervalo"
+// @Success      200       {array}   services.Occurrence
+// @Failure      400       {object}  util.ValidationErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules/preview [post]
+func (h *ScheduleController) PreviewSchedule(c *gin.Context) {
+	var req PreviewScheduleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	schedule, fields := req.schedule()
+	if fields != nil {
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: fields,
+		})
+		return
+	}
+
+	from, to, ok := parseRange(c, req.From, req.To)
+	if !ok {
+		return
+	}
+
+	occurrences, err := h.scheduleService.Preview(*schedule, *from, *to)
+
+	if err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, occurrences)
+}
+
+// PreviewScheduleByID godoc
+// @Summary      Lista as ocorrências de uma agenda
+// @Description  Lista as ocorrências da agenda no intervalo informado (máximo 366 dias), indicando as canceladas e a reunião já criada para cada uma
+// @Tags         schedule
+// @Produce      json
+// @Param        id    path      string true "Id da agenda"
+// @Param        from  query     string true "Data inicial no estilo: 2025-08-01"
+// @Param        to    query     string true "Data final no estilo: 2025-08-31"
+// @Success      200   {array}   services.Occurrence
+// @Failure      400   {object}  util.ErrorResponse
+// @Failure      404   {object}  util.ErrorResponse
+// @Failure      500   {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /schedules/{id}/preview [get]
+func (h *ScheduleController) PreviewScheduleByID(c *gin.Context) {
+	from, to, ok := parseRange(c, c.Query("from"), c.Query("to"))
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+
+	occurrences, err := h.scheduleService.PreviewByID(ctx, c.Param("id"), *from, *to)
+
+	if err != nil {
+		respondScheduleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, occurrences)
+}
+
+// parseRange parses the from and to dates of a preview, writing the error
+// response when one of them is invalid.
+func parseRange(c *gin.Context, fromValue, toValue string) (*time.Time, *time.Time, bool) {
+	from, err := parseDate(fromValue)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date", "details": err.Error()})
+		return nil, nil, false
+	}
+
+	to, err := parseDate(toValue)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date", "details": err.Error()})
+		return nil, nil, false
+	}
+
+	return from, to, true
+}
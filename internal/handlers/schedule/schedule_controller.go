@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"nitelog/internal/services/schedule"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScheduleController struct {
+	scheduleService *services.ScheduleService
+}
+
+func NewScheduleController(scheduleService *services.ScheduleService) *ScheduleController {
+	return &ScheduleController{scheduleService: scheduleService}
+}
+
+var errInvalidDateFormat = errors.New("dates must use the format 2025-04-02")
+
+// parseDate parses a YYYY-MM-DD date into the normalized form used by the
+// services.
+func parseDate(value string) (*time.Time, error) {
+	date, err := util.ParseDate(value)
+	if err != nil {
+		return nil, errInvalidDateFormat
+	}
+
+	return util.NormalizeDate(date)
+}
+
+// respondScheduleError writes the response for the errors shared by the
+// schedule endpoints.
+func respondScheduleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrScheduleNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Schedule not found"})
+	case errors.Is(err, services.ErrInvalidRule):
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: map[string]string{"rule": err.Error()},
+		})
+	case errors.Is(err, services.ErrInvalidClock):
+		c.JSON(http.StatusBadRequest, util.ValidationErrorResponse{
+			Error:  "validation failed",
+			Fields: map[string]string{"start_time": err.Error(), "end_time": err.Error()},
+		})
+	case errors.Is(err, services.ErrInvalidDateRange), errors.Is(err, services.ErrPreviewRangeLimit):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrNotAnOccurrence):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrAlreadyCancelled):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
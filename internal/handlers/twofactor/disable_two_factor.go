@@ -0,0 +1,67 @@
+package twofactor
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DisableTwoFactor godoc
+// @Summary      Desativa a autenticação em dois fatores
+// @Description  Remove os dois fatores do usuário autenticado, exige um código TOTP ou de recuperação. Não é permitido quando os papéis do usuário exigem dois fatores
+// @Tags         two_factor
+// @Accept       json
+// @Produce      json
+// @Param        request  body      TwoFactorCodeRequest  true  "Código TOTP ou de recuperação"
+// @Success      200      {object}  util.MessageResponse
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      403      {object}  util.ErrorResponse
+// @Failure      409      {object}  util.ErrorResponse
+// @Failure      429      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/2fa/disable [post]
+func (h *TwoFactorController) DisableTwoFactor(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	user, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+
+	if err := h.twoFactorService.Disable(ctx, user, req.Code); err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+// ResetTwoFactor godoc
+// @Summary      Remove os dois fatores de um usuário
+// @Description  Remove a autenticação em dois fatores de um usuário que perdeu o aplicativo autenticador e os códigos de recuperação, encerrando todas as sessões dele
+// @Tags         user_admin
+// @Produce      json
+// @Param        user_id  path      string true "Id do usuário"
+// @Success      200      {object}  util.MessageResponse
+// @Failure      404      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/2fa [delete]
+func (h *TwoFactorController) ResetTwoFactor(c *gin.Context) {
+	ctx := context.Background()
+
+	if err := h.twoFactorService.Reset(ctx, c.Param("id")); err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication reset"})
+}
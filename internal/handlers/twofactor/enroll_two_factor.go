@@ -0,0 +1,94 @@
+package twofactor
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	twoFactorServices "nitelog/internal/services/twofactor"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
+)
+
+const qrCodeSize = 256
+
+// EnrollTwoFactor godoc
+// @Summary      Inicia a autenticação em dois fatores
+// @Description  Gera um segredo TOTP para o usuário autenticado e retorna a URI otpauth e um QR code (PNG em data URI) para o aplicativo autenticador. Os dois fatores só passam a valer depois da confirmação
+// @Tags         two_factor
+// @Produce      json
+// @Success      200  {object}  services.TwoFactorEnrollment
+// @Failure      401  {object}  util.ErrorResponse
+// @Failure      409  {object}  util.ErrorResponse
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/2fa/enroll [post]
+func (h *TwoFactorController) EnrollTwoFactor(c *gin.Context) {
+	ctx := context.Background()
+
+	user, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+
+	enrollment, err := h.twoFactorService.Enroll(ctx, user)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	image, err := util.RenderQRCodePNG(enrollment.URI, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Unable to render QR code",
+			"details": err.Error(),
+		})
+		return
+	}
+	enrollment.QRCode = "data:image/png;base64," + base64.StdEncoding.EncodeToString(image)
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, enrollment)
+}
+
+// ConfirmTwoFactor godoc
+// @Summary      Confirma a autenticação em dois fatores
+// @Description  Ativa os dois fatores com um código do aplicativo autenticador e retorna os códigos de recuperação, que não são exibidos novamente. Todas as sessões do usuário são encerradas
+// @Tags         two_factor
+// @Accept       json
+// @Produce      json
+// @Param        request  body      TwoFactorCodeRequest  true  "Código TOTP"
+// @Success      200      {object}  services.RecoveryCodes
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      404      {object}  util.ErrorResponse
+// @Failure      409      {object}  util.ErrorResponse
+// @Failure      429      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/2fa/confirm [post]
+func (h *TwoFactorController) ConfirmTwoFactor(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx := context.Background()
+
+	codes, err := h.twoFactorService.Confirm(ctx, principal.UserID, req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, twoFactorServices.RecoveryCodes{RecoveryCodes: codes})
+}
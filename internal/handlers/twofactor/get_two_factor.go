@@ -0,0 +1,35 @@
+package twofactor
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTwoFactor godoc
+// @Summary      Situação da autenticação em dois fatores
+// @Description  Informa se o usuário autenticado tem dois fatores ativos, se os papéis dele exigem e quantos códigos de recuperação restam
+// @Tags         two_factor
+// @Produce      json
+// @Success      200  {object}  services.TwoFactorStatus
+// @Failure      401  {object}  util.ErrorResponse
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/2fa [get]
+func (h *TwoFactorController) GetTwoFactor(c *gin.Context) {
+	ctx := context.Background()
+
+	user, ok := h.caller(ctx, c)
+	if !ok {
+		return
+	}
+
+	status, err := h.twoFactorService.Status(ctx, user)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
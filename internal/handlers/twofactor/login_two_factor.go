@@ -0,0 +1,61 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	twoFactorServices "nitelog/internal/services/twofactor"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." binding:"required"`
+	Code           string `json:"code" example:"123456" binding:"required"`
+}
+
+// LoginTwoFactor godoc
+// @Summary      Conclui o login com o segundo fator
+// @Description  Troca o challenge_token retornado pelo login e um código TOTP ou de recuperação pelo token JWT e pelo refresh token
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request  body      LoginTwoFactorRequest  true  "Desafio e código"
+// @Success      200      {object}  services.TokenPair
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      401      {object}  util.ErrorResponse
+// @Failure      429      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Router       /users/login/2fa [post]
+func (h *TwoFactorController) LoginTwoFactor(c *gin.Context) {
+	var req LoginTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := h.twoFactorService.Verify(ctx, req.ChallengeToken, req.Code)
+
+	if errors.Is(err, twoFactorServices.ErrInvalidChallenge) ||
+		errors.Is(err, twoFactorServices.ErrInvalidCode) ||
+		errors.Is(err, twoFactorServices.ErrCodeAlreadyUsed) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	tokens, err := h.authService.Login(ctx, user, true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
@@ -0,0 +1,50 @@
+package twofactor
+
+import (
+	"context"
+	"net/http"
+
+	twoFactorServices "nitelog/internal/services/twofactor"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegenerateRecoveryCodes godoc
+// @Summary      Gera novos códigos de recuperação
+// @Description  Substitui os códigos de recuperação do usuário autenticado, os anteriores deixam de valer. Exige um código TOTP ou de recuperação
+// @Tags         two_factor
+// @Accept       json
+// @Produce      json
+// @Param        request  body      TwoFactorCodeRequest  true  "Código TOTP ou de recuperação"
+// @Success      200      {object}  services.RecoveryCodes
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      409      {object}  util.ErrorResponse
+// @Failure      429      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/2fa/recovery-codes [post]
+func (h *TwoFactorController) RegenerateRecoveryCodes(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx := context.Background()
+
+	codes, err := h.twoFactorService.RegenerateRecoveryCodes(ctx, principal.UserID, req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, twoFactorServices.RecoveryCodes{RecoveryCodes: codes})
+}
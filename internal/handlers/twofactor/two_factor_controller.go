@@ -0,0 +1,74 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/models"
+	authServices "nitelog/internal/services/auth"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TwoFactorController struct {
+	twoFactorService *twoFactorServices.TwoFactorService
+	userService      *userServices.UserService
+	authService      *authServices.AuthService
+}
+
+func NewTwoFactorController(
+	twoFactorService *twoFactorServices.TwoFactorService,
+	userService *userServices.UserService,
+	authService *authServices.AuthService,
+) *TwoFactorController {
+	return &TwoFactorController{
+		twoFactorService: twoFactorService,
+		userService:      userService,
+		authService:      authService,
+	}
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" example:"123456" binding:"required"`
+}
+
+// caller loads the user behind the access token, responding on failure.
+func (h *TwoFactorController) caller(ctx context.Context, c *gin.Context) (*models.User, bool) {
+	principal, err := util.GetPrincipal(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return nil, false
+	}
+
+	user, err := h.userService.GetByID(ctx, principal.UserID)
+	if err != nil {
+		respondTwoFactorError(c, err)
+		return nil, false
+	}
+
+	return user, true
+}
+
+func respondTwoFactorError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, twoFactorServices.ErrTwoFactorNotFound),
+		errors.Is(err, userServices.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, twoFactorServices.ErrTwoFactorEnabled),
+		errors.Is(err, twoFactorServices.ErrTwoFactorNotEnabled):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, twoFactorServices.ErrTwoFactorRequired):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, twoFactorServices.ErrInvalidCode),
+		errors.Is(err, twoFactorServices.ErrCodeAlreadyUsed):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, twoFactorServices.ErrTwoFactorLocked):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
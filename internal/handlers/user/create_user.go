@@ -3,10 +3,12 @@ package user
 import (
 	"context"
 	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	inviteServices "nitelog/internal/services/invite"
 	"nitelog/internal/services/user"
 	"nitelog/internal/util"
 )
@@ -14,23 +16,25 @@ import (
 type CreateUserRequest struct {
 	Name         string `json:"name" example:"John Testes" binding:"required"`
 	Registration string `json:"registration" example:"8854652123" binding:"required"`
-	Email        string `json:"email" example:"sample@email.com" binding:"required"`
+	Email        string `json:"email" example:"sample@email.com" binding:"required,email"`
 	Password     string `json:"password" example:"safePassword123#" binding:"required"`
+	InviteCode   string `json:"invite_code" example:"K7QX-M4TZ"`
 }
 
 // CreateUser godoc
 // @Summary      Cria um novo usuário
-// @Description  Cadastra um novo usuário no sistema
+// @Description  Cadastra um novo usuário no sistema e envia um link de verificação para o email informado. Conforme a política de cadastro, exige um código de convite ou um email de domínio permitido
 // @Tags         user
 // @Accept       json
 // @Produce      json
 // @Param        user  body      CreateUserRequest  true  "Dados do Usuário"
 // @Success      201   {object}  models.User
 // @Failure      400   {object}  util.ErrorResponse
+// @Failure      403   {object}  util.ErrorResponse
 // @Failure      409   {object}  util.ErrorResponse
 // @Failure      500   {object}  util.ErrorResponse
 // @Router       /users [post]
-func CreateUser(c *gin.Context) {
+func (h *UserController) CreateUser(c *gin.Context) {
 	var req CreateUserRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -47,8 +51,28 @@ func CreateUser(c *gin.Context) {
 	}
 
 	ctx := context.Background()
-	userService := services.NewUserService()
-	newUser, err := userService.Create(ctx, req.Registration, req.Email, req.Name, hash)
+
+	inviteCode, err := h.inviteService.Admit(ctx, req.Email, req.InviteCode)
+
+	if errors.Is(err, inviteServices.ErrInviteRequired) ||
+		errors.Is(err, inviteServices.ErrInvalidInvite) ||
+		errors.Is(err, inviteServices.ErrEmailDomainNotAllowed) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	newUser, err := h.userService.Create(ctx, req.Registration, req.Email, req.Name, hash)
+
+	if err != nil {
+		if err := h.inviteService.Release(ctx, inviteCode); err != nil {
+			log.Printf("failed to release invite %s: %v", inviteCode, err)
+		}
+	}
 
 	if errors.Is(err, services.ErrEmailTaken) || errors.Is(err, services.ErrRegistrationTaken) {
 		c.JSON(http.StatusConflict, gin.H{
@@ -65,5 +89,11 @@ func CreateUser(c *gin.Context) {
 		return
 	}
 
+	// The account exists either way, the verification can be resent by an
+	// admin when the email did not go out.
+	if err := h.verificationService.Send(ctx, newUser); err != nil {
+		log.Printf("failed to send verification email to user %s: %v", newUser.ID, err)
+	}
+
 	c.JSON(http.StatusCreated, newUser)
 }
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"net/http"
 	"nitelog/internal/services/user"
-	"slices"
 
 	"github.com/gin-gonic/gin"
 )
@@ -23,31 +22,12 @@ import (
 // @Failure      500         {object}  util.ErrorResponse
 // @Security BearerAuth
 // @Router       /users/delete/:id [delete]
-func DeleteUser(c *gin.Context) {
-	_, exists := c.Get("userID")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
-		return
-	}
-
+func (h *UserController) DeleteUser(c *gin.Context) {
 	id := c.Param("id")
 
 	ctx := context.Background()
-	userService := services.NewUserService()
-
-	user, err := userService.GetByID(ctx, id)
-
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if !slices.Contains(user.Roles, "admin") {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized, user is not admin"})
-		return
-	}
 
-	err = userService.SoftDelete(ctx, id)
+	err := h.userService.SoftDelete(ctx, id)
 
 	if errors.Is(err, services.ErrUserNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -59,6 +39,11 @@ func DeleteUser(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.authService.LogoutAll(ctx, id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "User marked as deleted successfully",
 		"details": "The user has been soft deleted and can be recovered",
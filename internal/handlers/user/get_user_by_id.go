@@ -23,13 +23,12 @@ import (
 // @Failure      500         {object}  util.ErrorResponse
 // @securityDefinitions.apikey  BearerAuth
 // @Router       /users/:id [get]
-func GetUserByID(c *gin.Context) {
+func (h *UserController) GetUserByID(c *gin.Context) {
 	id := c.Param("id")
 
 	ctx := context.Background()
 
-	userService := services.NewUserService()
-	user, err := userService.GetByID(ctx, id)
+	user, err := h.userService.GetByID(ctx, id)
 
 	if errors.Is(err, services.ErrUserNotFound) {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -2,33 +2,70 @@ package user
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"nitelog/internal/services/user"
 
 	"github.com/gin-gonic/gin"
 )
 
-// GetMeetingByID godoc
-// @Summary      Retorna todos os usuários
-// @Description  Retorna lista com todos os usuários
+// GetUsers godoc
+// @Summary      Lista usuários
+// @Description  Lista usuários paginados por cursor, com ordenação, filtro por papel e busca por prefixo de nome, email ou matrícula
 // @Tags         user_admin
 // @Produce      json
-// @Success      200         {object}  []models.User
-// @Failure      400         {object}  util.ErrorResponse
-// @Failure      500         {object}  util.ErrorResponse
-// @securityDefinitions.apikey  BearerAuth
+// @Param        sort      query     string false "Ordenação: name, registration ou created_at"
+// @Param        order     query     string false "Direção: asc ou desc"
+// @Param        role      query     string false "Filtra pelo papel do usuário"
+// @Param        search    query     string false "Prefixo de nome, email ou matrícula"
+// @Param        limit     query     int    false "Tamanho da página (máximo 200)"
+// @Param        cursor    query     string false "Cursor retornado pela página anterior"
+// @Success      200       {object}  services.UserPage
+// @Failure      400       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
 // @Router       /users [get]
-func GetUsers(c *gin.Context) {
+func (h *UserController) GetUsers(c *gin.Context) {
+	opts := services.UserListOptions{
+		Sort:   c.Query("sort"),
+		Role:   c.Query("role"),
+		Search: c.Query("search"),
+		Cursor: c.Query("cursor"),
+	}
+
+	switch c.DefaultQuery("order", "asc") {
+	case "asc":
+	case "desc":
+		opts.Descending = true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order, use asc or desc"})
+		return
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+			return
+		}
+		opts.Limit = limit
+	}
+
 	ctx := context.Background()
 
-	userService := services.NewUserService()
-	users, err := userService.GetAllUsers(ctx)
+	page, err := h.userService.List(ctx, opts)
+
+	if errors.Is(err, services.ErrInvalidSort) || errors.Is(err, services.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, users)
+	c.JSON(http.StatusOK, page)
 }
@@ -6,8 +6,6 @@ import (
 
 	"github.com/gin-gonic/gin"
 
-	"nitelog/internal/config"
-	"nitelog/internal/services/user"
 	"nitelog/internal/util"
 )
 
@@ -16,23 +14,20 @@ type LoginUserRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
-type LoginUserResponse struct {
-	Token string `json:"token"`
-}
-
 // LoginUser godoc
 // @Summary      Autentica um usuário
-// @Description  Gera token JWT para usuário válido
+// @Description  Abre uma sessão para o usuário, retornando um token JWT de curta duração e um refresh token para renová-lo. Se o usuário tiver autenticação em dois fatores, retorna um challenge_token a ser trocado em /users/login/2fa
 // @Tags         user
 // @Accept       json
 // @Produce      json
 // @Param        credentials  body      LoginUserRequest  true  "Credenciais"
-// @Success      200          {object}  LoginUserResponse
+// @Success      200          {object}  services.TokenPair
+// @Success      202          {object}  services.TwoFactorChallenge
 // @Failure      400          {object}  util.ErrorResponse
 // @Failure      401          {object}  util.ErrorResponse
 // @Failure      500          {object}  util.ErrorResponse
 // @Router       /users/login [post]
-func LoginUser(c *gin.Context) {
+func (h *UserController) LoginUser(c *gin.Context) {
 	var req LoginUserRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -42,8 +37,7 @@ func LoginUser(c *gin.Context) {
 
 	ctx := context.Background()
 
-	userService := services.NewUserService()
-	user, err := userService.GetByEmail(ctx, req.Email)
+	user, err := h.userService.GetByEmail(ctx, req.Email)
 
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid email"})
@@ -55,16 +49,28 @@ func LoginUser(c *gin.Context) {
 		return
 	}
 
-	cfg := config.Load()
-	token, err := util.GenerateJWT(user.ID, cfg.JWTSecret)
+	enabled, err := h.twoFactorService.Enabled(ctx, user.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate token"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if enabled {
+		challenge, err := h.twoFactorService.Challenge(user)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate challenge"})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, challenge)
 		return
 	}
 
-	res := LoginUserResponse{
-		Token: token,
+	tokens, err := h.authService.Login(ctx, user, false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate token"})
+		return
 	}
 
-	c.JSON(http.StatusOK, res)
+	c.JSON(http.StatusOK, tokens)
 }
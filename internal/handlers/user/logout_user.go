@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"nitelog/internal/util"
+)
+
+// LogoutUser godoc
+// @Summary      Encerra a sessão
+// @Description  Revoga a sessão do token usado na requisição. Com all=true revoga todas as sessões do usuário
+// @Tags         user
+// @Produce      json
+// @Param        all  query     bool false "Encerra todas as sessões"
+// @Success      200  {object}  util.MessageResponse
+// @Failure      401  {object}  util.ErrorResponse
+// @Failure      500  {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/logout [post]
+func (h *UserController) LogoutUser(c *gin.Context) {
+	userID, err := util.GetAuthJWT(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	ctx := context.Background()
+
+	if c.Query("all") == "true" {
+		if _, err := h.authService.LogoutAll(ctx, userID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"message": "All sessions logged out"})
+		return
+	}
+
+	if err := h.authService.Logout(ctx, c.GetString("sessionID")); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out"})
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"nitelog/internal/services/auth"
+)
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// RefreshToken godoc
+// @Summary      Renova o token de acesso
+// @Description  Troca um refresh token por um novo token JWT e um novo refresh token. Cada refresh token só pode ser usado uma vez, reutilizá-lo encerra a sessão
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        token  body      RefreshTokenRequest  true  "Refresh token"
+// @Success      200    {object}  services.TokenPair
+// @Failure      400    {object}  util.ErrorResponse
+// @Failure      401    {object}  util.ErrorResponse
+// @Failure      500    {object}  util.ErrorResponse
+// @Router       /users/refresh [post]
+func (h *UserController) RefreshToken(c *gin.Context) {
+	var req RefreshTokenRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	tokens, err := h.authService.Refresh(ctx, req.RefreshToken)
+
+	if errors.Is(err, services.ErrInvalidRefreshToken) || errors.Is(err, services.ErrRefreshTokenReused) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
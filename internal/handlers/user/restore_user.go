@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetDeletedUsers godoc
+// @Summary      Lista usuários deletados
+// @Description  Retorna os usuários removidos que ainda podem ser restaurados
+// @Tags         user_admin
+// @Produce      json
+// @Success      200         {object}  []models.User
+// @Failure      500         {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/deleted [get]
+func (h *UserController) GetDeletedUsers(c *gin.Context) {
+	ctx := context.Background()
+
+	users, err := h.userService.ListDeleted(ctx)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+// RestoreUser godoc
+// @Summary      Restaura um usuário
+// @Description  Restaura um usuário deletado, falhando caso o email ou a matrícula já estejam em uso
+// @Tags         user_admin
+// @Produce      json
+// @Param        user_id   path      string true "Id do usuário"
+// @Success      200       {object}  models.User
+// @Failure      404       {object}  util.ErrorResponse
+// @Failure      409       {object}  util.ValidationErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/restore [post]
+func (h *UserController) RestoreUser(c *gin.Context) {
+	ctx := context.Background()
+
+	user, err := h.userService.Restore(ctx, c.Param("id"))
+
+	if errors.Is(err, services.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Deleted user not found"})
+		return
+	}
+
+	fields := make(map[string]string)
+	if errors.Is(err, services.ErrEmailTaken) {
+		fields["email"] = services.ErrEmailTaken.Error()
+	}
+	if errors.Is(err, services.ErrRegistrationTaken) {
+		fields["registration"] = services.ErrRegistrationTaken.Error()
+	}
+
+	if len(fields) > 0 {
+		c.JSON(http.StatusConflict, util.ValidationErrorResponse{
+			Error:  "user cannot be restored",
+			Fields: fields,
+		})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
@@ -3,24 +3,27 @@ package user
 import (
 	"context"
 	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"nitelog/internal/models"
 	"nitelog/internal/services/user"
+	verificationServices "nitelog/internal/services/verification"
+	"nitelog/internal/util"
 )
 
 type UpdateUserRequest struct {
 	Registration string `firestore:"registration" json:"registration" example:"8854652123"`
-	Email        string `json:"email" example:"sample@email.com"`
+	Email        string `json:"email" example:"sample@email.com" binding:"omitempty,email"`
 	Password     string `jason:"password" example:"safePassword123#"`
 	Name         string `jason:"name" example:"Mary"`
 }
 
 // UpdateUser godoc
 // @Summary      Atualiza um usuário
-// @Description  Atualiza um usuário no sistema
+// @Description  Atualiza um usuário no sistema. Alterar a senha encerra todas as sessões do usuário e alterar o email exige uma nova verificação. Com a política de cadastro por domínio, o novo email precisa ser de um domínio permitido
 // @Tags         user
 // @Accept       json
 // @Produce      json
@@ -33,8 +36,8 @@ type UpdateUserRequest struct {
 // @Failure      500   {object}  util.ErrorResponse
 // @Security BearerAuth
 // @Router       /users/update/:id [put]
-func UpdateUser(c *gin.Context) {
-	user, err := services.GetAuthJWTWithUser(c)
+func (h *UserController) UpdateUser(c *gin.Context) {
+	principal, err := util.GetPrincipal(c)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"error":   "unauthorized",
@@ -44,7 +47,7 @@ func UpdateUser(c *gin.Context) {
 	}
 
 	idParam := c.Param("id")
-	if idParam != user.ID && !user.IsAdmin() {
+	if idParam != principal.UserID && !principal.Can(models.PermissionUsersManage) {
 		c.JSON(http.StatusForbidden, gin.H{"error": "cannot update other user"})
 		return
 	}
@@ -55,6 +58,13 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 
+	if req.Email != "" {
+		if err := h.inviteService.AllowEmailChange(req.Email); err != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	updatedUser := models.User{
 		Email:        req.Email,
 		Registration: req.Registration,
@@ -63,8 +73,7 @@ func UpdateUser(c *gin.Context) {
 	}
 
 	ctx := context.Background()
-	userService := services.NewUserService()
-	err = userService.Update(ctx, idParam, updatedUser)
+	err = h.userService.Update(ctx, idParam, updatedUser)
 
 	if errors.Is(err, services.ErrNoChangesDetected) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -89,5 +98,22 @@ func UpdateUser(c *gin.Context) {
 		return
 	}
 
+	if req.Email != "" {
+		err := h.verificationService.SendByID(ctx, idParam)
+		if err != nil && !errors.Is(err, verificationServices.ErrEmailAlreadyVerified) {
+			log.Printf("failed to send verification email to user %s: %v", idParam, err)
+		}
+	}
+
+	if req.Password != "" {
+		if _, err := h.authService.LogoutAll(ctx, idParam); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "User updated but sessions could not be revoked",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, "user updated successfully")
 }
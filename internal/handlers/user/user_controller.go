@@ -0,0 +1,33 @@
+package user
+
+import (
+	authServices "nitelog/internal/services/auth"
+	inviteServices "nitelog/internal/services/invite"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+	verificationServices "nitelog/internal/services/verification"
+)
+
+type UserController struct {
+	userService         *userServices.UserService
+	authService         *authServices.AuthService
+	verificationService *verificationServices.VerificationService
+	inviteService       *inviteServices.InviteService
+	twoFactorService    *twoFactorServices.TwoFactorService
+}
+
+func NewUserController(
+	userService *userServices.UserService,
+	authService *authServices.AuthService,
+	verificationService *verificationServices.VerificationService,
+	inviteService *inviteServices.InviteService,
+	twoFactorService *twoFactorServices.TwoFactorService,
+) *UserController {
+	return &UserController{
+		userService:         userService,
+		authService:         authService,
+		verificationService: verificationService,
+		inviteService:       inviteService,
+		twoFactorService:    twoFactorService,
+	}
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"nitelog/internal/models"
+	"nitelog/internal/services/user"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AssignRoleRequest struct {
+	Role string `json:"role" example:"coordinator" binding:"required"`
+}
+
+// AssignRole godoc
+// @Summary      Atribui um papel
+// @Description  Atribui um papel (admin, coordinator, auditor ou member) ao usuário e encerra todas as sessões dele
+// @Tags         user_admin
+// @Accept       json
+// @Produce      json
+// @Param        user_id   path      string            true "Id do usuário"
+// @Param        role      body      AssignRoleRequest true "Papel a atribuir"
+// @Success      200       {object}  models.User
+// @Failure      400       {object}  util.ErrorResponse
+// @Failure      404       {object}  util.ErrorResponse
+// @Failure      409       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/roles [post]
+func (h *UserController) AssignRole(c *gin.Context) {
+	var req AssignRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := h.userService.AssignRole(ctx, c.Param("id"), req.Role)
+	h.respondRoleChange(ctx, c, user, err)
+}
+
+// RevokeRole godoc
+// @Summary      Revoga um papel
+// @Description  Remove um papel do usuário e encerra todas as sessões dele. O papel admin do último administrador não pode ser removido
+// @Tags         user_admin
+// @Produce      json
+// @Param        user_id   path      string true "Id do usuário"
+// @Param        role      path      string true "Papel a revogar"
+// @Success      200       {object}  models.User
+// @Failure      400       {object}  util.ErrorResponse
+// @Failure      404       {object}  util.ErrorResponse
+// @Failure      409       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/roles/:role [delete]
+func (h *UserController) RevokeRole(c *gin.Context) {
+	ctx := context.Background()
+
+	user, err := h.userService.RevokeRole(ctx, c.Param("id"), c.Param("role"))
+	h.respondRoleChange(ctx, c, user, err)
+}
+
+// respondRoleChange logs the user out everywhere once its roles changed, the
+// access tokens still carry the previous roles.
+func (h *UserController) respondRoleChange(ctx context.Context, c *gin.Context, user *models.User, err error) {
+	if errors.Is(err, services.ErrUnknownRole) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+			"roles": models.Roles(),
+		})
+		return
+	}
+
+	if errors.Is(err, services.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, services.ErrRoleAssigned) ||
+		errors.Is(err, services.ErrRoleNotAssigned) ||
+		errors.Is(err, services.ErrLastAdmin) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := h.authService.LogoutAll(ctx, user.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Roles updated but sessions could not be revoked",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
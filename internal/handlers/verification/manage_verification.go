@@ -0,0 +1,82 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	userServices "nitelog/internal/services/user"
+	verificationServices "nitelog/internal/services/verification"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResendVerification godoc
+// @Summary      Reenvia a verificação de email
+// @Description  Envia novamente o link de verificação para o email do usuário
+// @Tags         user_admin
+// @Produce      json
+// @Param        user_id   path      string true "Id do usuário"
+// @Success      202       {object}  util.MessageResponse
+// @Failure      404       {object}  util.ErrorResponse
+// @Failure      409       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/email/verification [post]
+func (h *VerificationController) ResendVerification(c *gin.Context) {
+	ctx := context.Background()
+
+	err := h.verificationService.SendByID(ctx, c.Param("id"))
+
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, verificationServices.ErrEmailAlreadyVerified) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Verification email sent"})
+}
+
+// ConfirmEmail godoc
+// @Summary      Confirma o email manualmente
+// @Description  Marca o email do usuário como verificado sem exigir o link enviado por email
+// @Tags         user_admin
+// @Produce      json
+// @Param        user_id   path      string true "Id do usuário"
+// @Success      200       {object}  models.User
+// @Failure      404       {object}  util.ErrorResponse
+// @Failure      409       {object}  util.ErrorResponse
+// @Failure      500       {object}  util.ErrorResponse
+// @Security     BearerAuth
+// @Router       /users/:id/email/verify [post]
+func (h *VerificationController) ConfirmEmail(c *gin.Context) {
+	ctx := context.Background()
+
+	user, err := h.verificationService.Confirm(ctx, c.Param("id"))
+
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, verificationServices.ErrEmailAlreadyVerified) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
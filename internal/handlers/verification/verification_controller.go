@@ -0,0 +1,15 @@
+package verification
+
+import (
+	verificationServices "nitelog/internal/services/verification"
+)
+
+type VerificationController struct {
+	verificationService *verificationServices.VerificationService
+}
+
+func NewVerificationController(verificationService *verificationServices.VerificationService) *VerificationController {
+	return &VerificationController{
+		verificationService: verificationService,
+	}
+}
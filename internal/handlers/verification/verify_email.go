@@ -0,0 +1,50 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	verificationServices "nitelog/internal/services/verification"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." binding:"required"`
+}
+
+// VerifyEmail godoc
+// @Summary      Confirma o email
+// @Description  Confirma o email do usuário usando o token recebido no cadastro
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Param        request  body      VerifyEmailRequest  true  "Token de verificação"
+// @Success      200      {object}  models.User
+// @Failure      400      {object}  util.ErrorResponse
+// @Failure      500      {object}  util.ErrorResponse
+// @Router       /users/email/verify [post]
+func (h *VerificationController) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := h.verificationService.Verify(ctx, req.Token)
+
+	if errors.Is(err, verificationServices.ErrInvalidVerificationToken) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
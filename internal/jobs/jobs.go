@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Every runs fn right away and then once per interval until ctx is
+// cancelled. Each run gets its own timeout and failures are only logged, so
+// one bad run does not stop the schedule.
+func Every(ctx context.Context, name string, interval, timeout time.Duration, fn func(context.Context) error) {
+	if interval <= 0 {
+		log.Printf("job %s disabled", name)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		run(ctx, name, timeout, fn)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func run(ctx context.Context, name string, timeout time.Duration, fn func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := fn(ctx); err != nil {
+		log.Printf("job %s failed: %v", name, err)
+	}
+}
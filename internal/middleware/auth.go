@@ -1,15 +1,23 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
-	services "nitelog/internal/services/user"
+	"nitelog/internal/models"
+	"nitelog/internal/util"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
 )
 
-func JWT(secret string) gin.HandlerFunc {
+// RevocationList tells whether the session an access token belongs to was
+// logged out.
+type RevocationList interface {
+	IsRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+func JWT(secret string, revocations RevocationList) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -20,7 +28,7 @@ func JWT(secret string) gin.HandlerFunc {
 		}
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		claims := &jwt.StandardClaims{}
+		claims := &util.Claims{}
 		token, err := jwt.ParseWithClaims(
 			tokenString,
 			claims,
@@ -45,7 +53,29 @@ func JWT(secret string) gin.HandlerFunc {
 			return
 		}
 
+		if claims.Id == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "token expired or invalid",
+			})
+			return
+		}
+
+		revoked, err := revocations.IsRevoked(c.Request.Context(), claims.Id)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "token revoked",
+			})
+			return
+		}
+
 		c.Set("userID", claims.Subject)
+		c.Set("sessionID", claims.Id)
+		c.Set("principal", claims.Principal())
 		c.Next()
 	}
 }
@@ -78,16 +108,27 @@ func CORS() gin.HandlerFunc {
 	}
 }
 
-func AdminOnly() gin.HandlerFunc {
+// RequirePermission relies on the roles carried by the token, it must run
+// after JWT.
+func RequirePermission(permission models.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		user, err := services.GetAuthJWTWithUser(c)
+		principal, err := util.GetPrincipal(c)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
 			return
 		}
 
-		if !user.IsAdmin() {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "user not admin"})
+		if principal.TwoFactorRequired {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "two-factor authentication required",
+			})
+			return
+		}
+
+		if !principal.Can(permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "missing permission " + string(permission),
+			})
 			return
 		}
 
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type revokedSessions map[string]bool
+
+func (r revokedSessions) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return r[sessionID], nil
+}
+
+func newTestRouter(revoked revokedSessions) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/reports",
+		JWT("test", revoked),
+		RequirePermission(models.PermissionReportsRead),
+		func(c *gin.Context) { c.Status(http.StatusOK) },
+	)
+
+	return router
+}
+
+func TestRequirePermission(t *testing.T) {
+	router := newTestRouter(revokedSessions{"revoked": true})
+
+	tests := []struct {
+		name      string
+		sessionID string
+		roles     []string
+		ttl       time.Duration
+		want      int
+	}{
+		{"admin", "session", []string{models.RoleAdmin}, time.Minute, http.StatusOK},
+		{"auditor", "session", []string{models.RoleAuditor}, time.Minute, http.StatusOK},
+		{"member", "session", []string{models.RoleMember}, time.Minute, http.StatusForbidden},
+		{"unknown role", "session", []string{"owner"}, time.Minute, http.StatusForbidden},
+		{"no roles", "session", nil, time.Minute, http.StatusForbidden},
+		{"revoked session", "revoked", []string{models.RoleAdmin}, time.Minute, http.StatusUnauthorized},
+		{"expired", "session", []string{models.RoleAdmin}, -time.Minute, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := util.GenerateJWT(models.Principal{
+				UserID:    "user",
+				SessionID: tt.sessionID,
+				Roles:     tt.roles,
+			}, "test", tt.ttl)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/reports", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWTWithoutToken(t *testing.T) {
+	router := newTestRouter(revokedSessions{})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/reports", nil))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
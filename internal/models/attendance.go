@@ -4,9 +4,17 @@ import (
 	"time"
 )
 
+// Reasons an attendance was closed by the system instead of by its owner.
+const (
+	AutoCloseMeetingEnded = "meeting_ended"
+	AutoCloseCutoff       = "cutoff"
+)
+
 // @model Attendance
 type Attendance struct {
-	Registration string     `firestore:"registration" json:"registration" example:"8854652123"`
-	StartTime    time.Time  `firestore:"startTime" json:"start_time" example:"2025-05-14T20:19:02.1Z"`
-	EndTime      *time.Time `firestore:"endTime,omitempty" json:"end_time,omitempty" example:"2025-05-14T22:12:34.1Z"`
+	Registration    string     `firestore:"registration" json:"registration" example:"8854652123"`
+	StartTime       time.Time  `firestore:"startTime" json:"start_time" example:"2025-05-14T20:19:02.1Z"`
+	EndTime         *time.Time `firestore:"endTime,omitempty" json:"end_time,omitempty" example:"2025-05-14T22:12:34.1Z"`
+	AutoClosed      bool       `firestore:"autoClosed,omitempty" json:"auto_closed,omitempty" example:"false"`
+	AutoCloseReason string     `firestore:"autoCloseReason,omitempty" json:"auto_close_reason,omitempty" example:"meeting_ended"`
 }
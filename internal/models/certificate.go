@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// @model Certificate
+type Certificate struct {
+	Code         string    `firestore:"-" json:"code" example:"K7QX-M4TZ-9PWR"`
+	UserID       string    `firestore:"userId" json:"user_id" example:"d4e5f6a7b8c9d0e1f2a3b4c5"`
+	Name         string    `firestore:"name" json:"name" example:"John Testes"`
+	Registration string    `firestore:"registration" json:"registration" example:"5612879465"`
+	From         time.Time `firestore:"from" json:"from" example:"2025-08-01"`
+	To           time.Time `firestore:"to" json:"to" example:"2025-08-31"`
+	Meetings     int       `firestore:"meetings" json:"meetings" example:"8"`
+	TotalSeconds int64     `firestore:"totalSeconds" json:"total_seconds" example:"86400"`
+	IssuedAt     time.Time `firestore:"issuedAt" json:"issued_at" example:"2025-09-01T14:03:27Z"`
+}
+
+// @model CertificateVerification
+type CertificateVerification struct {
+	Valid        bool      `json:"valid" example:"true"`
+	Code         string    `json:"code" example:"K7QX-M4TZ-9PWR"`
+	Name         string    `json:"name" example:"John Testes"`
+	From         time.Time `json:"from" example:"2025-08-01"`
+	To           time.Time `json:"to" example:"2025-08-31"`
+	Meetings     int       `json:"meetings" example:"8"`
+	TotalSeconds int64     `json:"total_seconds" example:"86400"`
+	IssuedAt     time.Time `json:"issued_at" example:"2025-09-01T14:03:27Z"`
+}
+
+// Verification is the public view of the certificate, limited to what is
+// printed on it.
+func (certificate *Certificate) Verification() CertificateVerification {
+	return CertificateVerification{
+		Valid:        true,
+		Code:         certificate.Code,
+		Name:         certificate.Name,
+		From:         certificate.From,
+		To:           certificate.To,
+		Meetings:     certificate.Meetings,
+		TotalSeconds: certificate.TotalSeconds,
+		IssuedAt:     certificate.IssuedAt,
+	}
+}
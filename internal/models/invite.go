@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// @model Invite
+type Invite struct {
+	Code      string     `firestore:"-" json:"code" example:"K7QX-M4TZ"`
+	Note      string     `firestore:"note" json:"note,omitempty" example:"Turma 2025.2"`
+	MaxUses   int        `firestore:"maxUses" json:"max_uses" example:"30"`
+	Uses      int        `firestore:"uses" json:"uses" example:"12"`
+	CreatedBy string     `firestore:"createdBy" json:"created_by" example:"d4e5f6a7b8c9d0e1f2a3b4c5"`
+	CreatedAt time.Time  `firestore:"createdAt" json:"created_at" example:"2025-08-01T12:00:00Z"`
+	ExpiresAt time.Time  `firestore:"expiresAt" json:"expires_at" example:"2025-08-08T12:00:00Z"`
+	RevokedAt *time.Time `firestore:"revokedAt" json:"revoked_at,omitempty" example:"2025-08-02T09:30:00Z"`
+}
+
+// Usable reports whether the invite can still admit a new user at now.
+func (invite *Invite) Usable(now time.Time) bool {
+	return invite.RevokedAt == nil && now.Before(invite.ExpiresAt) && invite.Uses < invite.MaxUses
+}
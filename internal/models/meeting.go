@@ -6,10 +6,49 @@ import (
 
 // @model Meeting
 type Meeting struct {
-	ID          string       `firestore:"-" json:"id" example:"a1b2c3d4e5f6g7h8i9j0k1"`
-	Date        time.Time    `firestore:"date" json:"date" example:"2024-10-26"`
-	MeetingCode string       `firestore:"meetingCode" json:"meeting_code" example:"qE522Af8"`
-	Attendance  []Attendance `firestore:"attendance" json:"attendance"`
-	CreatedAt   time.Time    `firestore:"createdAt" json:"created_at" example:"2025-05-14T20:14:04.245Z"`
-	DeletedAt   *time.Time   `firestore:"deletedAt,omitempty" json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
+	ID            string       `firestore:"-" json:"id" example:"a1b2c3d4e5f6g7h8i9j0k1"`
+	Date          time.Time    `firestore:"date" json:"date" example:"2024-10-26"`
+	Title         string       `firestore:"title" json:"title" example:"Reunião noturna"`
+	Location      string       `firestore:"location,omitempty" json:"location,omitempty" example:"Laboratório 3"`
+	StartsAt      *time.Time   `firestore:"startsAt,omitempty" json:"starts_at,omitempty" example:"2024-10-26T19:00:00-03:00"`
+	EndsAt        *time.Time   `firestore:"endsAt,omitempty" json:"ends_at,omitempty" example:"2024-10-26T22:00:00-03:00"`
+	ScheduleID    string       `firestore:"scheduleId,omitempty" json:"schedule_id,omitempty" example:"b2c3d4e5f6a7b8c9d0e1"`
+	MeetingCode   string       `firestore:"meetingCode" json:"meeting_code,omitempty" example:"qE522Af8"`
+	CodeSecret    string       `firestore:"codeSecret,omitempty" json:"-"`
+	CodeRotatedAt *time.Time   `firestore:"codeRotatedAt,omitempty" json:"code_rotated_at,omitempty" example:"2025-05-14T20:30:00Z"`
+	CodeRotatedBy string       `firestore:"codeRotatedBy,omitempty" json:"code_rotated_by,omitempty" example:"d4e5f6a7b8c9d0e1f2a3b4c5"`
+	Attendance    []Attendance `firestore:"attendance" json:"attendance"`
+	CreatedAt     time.Time    `firestore:"createdAt" json:"created_at" example:"2025-05-14T20:14:04.245Z"`
+	DeletedAt     *time.Time   `firestore:"deletedAt,omitempty" json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
+}
+
+// @model MeetingSummary
+type MeetingSummary struct {
+	ID              string     `json:"id" example:"a1b2c3d4e5f6g7h8i9j0k1"`
+	Date            time.Time  `json:"date" example:"2024-10-26"`
+	Title           string     `json:"title" example:"Reunião noturna"`
+	Location        string     `json:"location,omitempty" example:"Laboratório 3"`
+	StartsAt        *time.Time `json:"starts_at,omitempty" example:"2024-10-26T19:00:00-03:00"`
+	EndsAt          *time.Time `json:"ends_at,omitempty" example:"2024-10-26T22:00:00-03:00"`
+	ScheduleID      string     `json:"schedule_id,omitempty" example:"b2c3d4e5f6a7b8c9d0e1"`
+	MeetingCode     string     `json:"meeting_code,omitempty" example:"qE522Af8"`
+	AttendanceCount int        `json:"attendance_count" example:"12"`
+	CreatedAt       time.Time  `json:"created_at" example:"2025-05-14T20:14:04.245Z"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
+}
+
+func (meeting *Meeting) Summary() MeetingSummary {
+	return MeetingSummary{
+		ID:              meeting.ID,
+		Date:            meeting.Date,
+		Title:           meeting.Title,
+		Location:        meeting.Location,
+		StartsAt:        meeting.StartsAt,
+		EndsAt:          meeting.EndsAt,
+		ScheduleID:      meeting.ScheduleID,
+		MeetingCode:     meeting.MeetingCode,
+		AttendanceCount: len(meeting.Attendance),
+		CreatedAt:       meeting.CreatedAt,
+		DeletedAt:       meeting.DeletedAt,
+	}
 }
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// PasswordReset is a one-time token emailed to a user who forgot the
+// password, only its hash is stored. Requests for unknown emails are
+// recorded too, without a user, so rate limiting behaves the same whether
+// the email exists or not.
+type PasswordReset struct {
+	ID        string     `firestore:"-"`
+	UserID    string     `firestore:"userId"`
+	Email     string     `firestore:"email"`
+	TokenHash string     `firestore:"tokenHash"`
+	CreatedAt time.Time  `firestore:"createdAt"`
+	ExpiresAt time.Time  `firestore:"expiresAt"`
+	UsedAt    *time.Time `firestore:"usedAt"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"slices"
+)
+
+// Principal is the caller of a request as described by its access token.
+// Roles are the ones the user had when the token was issued, the token
+// version tells which role change the token has seen. TwoFactorRequired is
+// set when the roles demand a second factor the login did not pass, such a
+// principal holds no permission until it enrolls and logs in again.
+type Principal struct {
+	UserID            string
+	SessionID         string
+	Roles             []string
+	TokenVersion      int
+	TwoFactor         bool
+	TwoFactorRequired bool
+}
+
+func (principal *Principal) IsAdmin() bool {
+	return slices.Contains(principal.Roles, RoleAdmin)
+}
+
+func (principal *Principal) Can(permission Permission) bool {
+	if principal.TwoFactorRequired {
+		return false
+	}
+	return HasPermission(principal.Roles, permission)
+}
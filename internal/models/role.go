@@ -0,0 +1,66 @@
+package models
+
+import (
+	"slices"
+)
+
+const (
+	RoleAdmin       = "admin"
+	RoleCoordinator = "coordinator"
+	RoleAuditor     = "auditor"
+	RoleMember      = "member"
+)
+
+// Permission is an action guarded by a role, routes and handlers check
+// permissions and never role names.
+type Permission string
+
+const (
+	PermissionMeetingsWrite     Permission = "meetings:write"
+	PermissionAttendanceManage  Permission = "attendance:manage"
+	PermissionSchedulesManage   Permission = "schedules:manage"
+	PermissionReportsRead       Permission = "reports:read"
+	PermissionCertificatesIssue Permission = "certificates:issue"
+	PermissionUsersRead         Permission = "users:read"
+	PermissionUsersManage       Permission = "users:manage"
+	PermissionRolesManage       Permission = "roles:manage"
+	PermissionInvitesManage     Permission = "invites:manage"
+	PermissionSystemPurge       Permission = "system:purge"
+)
+
+// rolePermissions lists what every known role grants, admin is handled
+// separately and holds every permission. Members only act on themselves,
+// which needs no permission.
+var rolePermissions = map[string][]Permission{
+	RoleCoordinator: {
+		PermissionMeetingsWrite,
+		PermissionAttendanceManage,
+		PermissionSchedulesManage,
+		PermissionReportsRead,
+		PermissionCertificatesIssue,
+		PermissionUsersRead,
+	},
+	RoleAuditor: {
+		PermissionReportsRead,
+	},
+	RoleMember: {},
+}
+
+func Roles() []string {
+	return []string{RoleAdmin, RoleCoordinator, RoleAuditor, RoleMember}
+}
+
+func IsKnownRole(role string) bool {
+	return slices.Contains(Roles(), role)
+}
+
+// HasPermission reports whether any of roles grants permission, unknown
+// roles grant nothing.
+func HasPermission(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		if role == RoleAdmin || slices.Contains(rolePermissions[role], permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// @model Schedule
+type Schedule struct {
+	ID            string      `firestore:"-" json:"id" example:"b2c3d4e5f6a7b8c9d0e1"`
+	Title         string      `firestore:"title" json:"title" example:"Reunião semanal"`
+	Location      string      `firestore:"location,omitempty" json:"location,omitempty" example:"Laboratório 3"`
+	Rule          string      `firestore:"rule" json:"rule" example:"FREQ=WEEKLY;BYDAY=TU,TH"`
+	StartDate     time.Time   `firestore:"startDate" json:"start_date" example:"2025-08-05"`
+	EndDate       *time.Time  `firestore:"endDate,omitempty" json:"end_date,omitempty" example:"2025-12-16"`
+	StartTime     string      `firestore:"startTime" json:"start_time" example:"19:00"`
+	EndTime       string      `firestore:"endTime" json:"end_time" example:"22:00"`
+	ExcludedDates []time.Time `firestore:"excludedDates" json:"excluded_dates"`
+	CreatedAt     time.Time   `firestore:"createdAt" json:"created_at" example:"2025-05-14T20:14:04.245Z"`
+	DeletedAt     *time.Time  `firestore:"deletedAt" json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// Session is a login of a user. It holds the hash of the current refresh
+// token, access tokens carry the session id so revoking the session also
+// invalidates them. TwoFactor tells whether the login passed the second
+// factor.
+type Session struct {
+	ID                string     `firestore:"-"`
+	UserID            string     `firestore:"userId"`
+	TokenVersion      int        `firestore:"tokenVersion"`
+	TokenHash         string     `firestore:"tokenHash"`
+	PreviousTokenHash string     `firestore:"previousTokenHash"`
+	CreatedAt         time.Time  `firestore:"createdAt"`
+	LastUsedAt        time.Time  `firestore:"lastUsedAt"`
+	ExpiresAt         time.Time  `firestore:"expiresAt"`
+	RevokedAt         *time.Time `firestore:"revokedAt"`
+	TwoFactor         bool       `firestore:"twoFactor"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// TwoFactor is the TOTP second factor of a user. It stays pending until the
+// user proves the authenticator works by confirming a first code. Recovery
+// codes are stored hashed, each one works once.
+type TwoFactor struct {
+	UserID         string     `firestore:"-"`
+	Secret         string     `firestore:"secret"`
+	RecoveryCodes  []string   `firestore:"recoveryCodes"`
+	LastCounter    int64      `firestore:"lastCounter"`
+	FailedAttempts int        `firestore:"failedAttempts"`
+	LockedUntil    *time.Time `firestore:"lockedUntil"`
+	CreatedAt      time.Time  `firestore:"createdAt"`
+	EnabledAt      *time.Time `firestore:"enabledAt"`
+}
+
+func (twoFactor *TwoFactor) IsEnabled() bool {
+	return twoFactor.EnabledAt != nil
+}
+
+// IsLocked reports whether too many wrong codes were given and codes must be
+// refused until LockedUntil.
+func (twoFactor *TwoFactor) IsLocked(now time.Time) bool {
+	return twoFactor.LockedUntil != nil && now.Before(*twoFactor.LockedUntil)
+}
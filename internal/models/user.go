@@ -7,17 +7,27 @@ import (
 
 // @model User
 type User struct {
-	ID           string     `firestore:"-" json:"id" example:"d4e5f6a7b8c9d0e1f2a3b4c5"`
-	Registration string     `firestore:"registration" json:"registration" example:"5612879465"`
-	Name         string     `firestore:"name" json:"name" example:"John Testes"`
-	Email        string     `firestore:"email" json:"email" example:"sample@email.com"`
-	PasswordHash string     `firestore:"passwordHash" json:"-"`
-	Roles        []string   `firestore:"roles" json:"roles"`
-	CreatedAt    time.Time  `firestore:"createdAt" json:"created_at" example:"2025-05-14T20:14:04.245Z"`
-	UpdatedAt    time.Time  `firestore:"updatedAt" json:"updated_at" example:"2026-05-14T12:18:34.245Z"`
-	DeletedAt    *time.Time `firestore:"deletedAt" json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
+	ID              string     `firestore:"-" json:"id" example:"d4e5f6a7b8c9d0e1f2a3b4c5"`
+	Registration    string     `firestore:"registration" json:"registration" example:"5612879465"`
+	Name            string     `firestore:"name" json:"name" example:"John Testes"`
+	Email           string     `firestore:"email" json:"email" example:"sample@email.com"`
+	PasswordHash    string     `firestore:"passwordHash" json:"-"`
+	Roles           []string   `firestore:"roles" json:"roles"`
+	TokenVersion    int        `firestore:"tokenVersion" json:"-"`
+	EmailVerifiedAt *time.Time `firestore:"emailVerifiedAt" json:"email_verified_at,omitempty" example:"2025-05-14T20:20:00Z"`
+	CreatedAt       time.Time  `firestore:"createdAt" json:"created_at" example:"2025-05-14T20:14:04.245Z"`
+	UpdatedAt       time.Time  `firestore:"updatedAt" json:"updated_at" example:"2026-05-14T12:18:34.245Z"`
+	DeletedAt       *time.Time `firestore:"deletedAt" json:"deleted_at,omitempty" example:"2025-05-15T09:45:00Z"`
 }
 
 func (user *User) IsAdmin() bool {
-	return slices.Contains(user.Roles, "admin")
+	return slices.Contains(user.Roles, RoleAdmin)
+}
+
+func (user *User) IsEmailVerified() bool {
+	return user.EmailVerifiedAt != nil
+}
+
+func (user *User) Can(permission Permission) bool {
+	return HasPermission(user.Roles, permission)
 }
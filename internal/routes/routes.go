@@ -5,18 +5,54 @@ import (
 	_ "nitelog/docs"
 	"nitelog/internal/config"
 	"nitelog/internal/middleware"
+	"nitelog/internal/models"
 
+	adminHandler "nitelog/internal/handlers/admin"
+	certificateHandler "nitelog/internal/handlers/certificate"
+	exportHandler "nitelog/internal/handlers/export"
+	inviteHandler "nitelog/internal/handlers/invite"
 	meetingHandler "nitelog/internal/handlers/meeting"
+	passwordHandler "nitelog/internal/handlers/password"
+	reportHandler "nitelog/internal/handlers/report"
+	scheduleHandler "nitelog/internal/handlers/schedule"
+	twoFactorHandler "nitelog/internal/handlers/twofactor"
 	userHandler "nitelog/internal/handlers/user"
+	verificationHandler "nitelog/internal/handlers/verification"
+	authServices "nitelog/internal/services/auth"
+	certificateServices "nitelog/internal/services/certificate"
+	exportServices "nitelog/internal/services/export"
+	inviteServices "nitelog/internal/services/invite"
+	meetingServices "nitelog/internal/services/meeting"
+	passwordServices "nitelog/internal/services/password"
+	purgeServices "nitelog/internal/services/purge"
+	reportServices "nitelog/internal/services/report"
+	scheduleServices "nitelog/internal/services/schedule"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+	verificationServices "nitelog/internal/services/verification"
 
-	"cloud.google.com/go/firestore"
 	"github.com/gin-gonic/gin"
 	"github.com/swaggo/files"
 
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func RegisterRoutes(router *gin.Engine, client *firestore.Client) {
+type Services struct {
+	Meetings     *meetingServices.MeetingService
+	Users        *userServices.UserService
+	Auth         *authServices.AuthService
+	Passwords    *passwordServices.PasswordService
+	Verification *verificationServices.VerificationService
+	Invites      *inviteServices.InviteService
+	TwoFactor    *twoFactorServices.TwoFactorService
+	Purge        *purgeServices.PurgeService
+	Schedules    *scheduleServices.ScheduleService
+	Reports      *reportServices.ReportService
+	Exports      *exportServices.ExportService
+	Certificates *certificateServices.CertificateService
+}
+
+func RegisterRoutes(router *gin.Engine, svc Services) {
 	cfg := config.Load()
 
 	router.Use(middleware.TimeoutMiddleware())
@@ -28,38 +64,161 @@ func RegisterRoutes(router *gin.Engine, client *firestore.Client) {
 	router.GET("/apidoc/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	{
+		meetingController := meetingHandler.NewMeetingController(svc.Meetings, svc.Users, cfg.RequireVerifiedEmail)
+		exportController := exportHandler.NewExportController(svc.Exports)
 		meetings := router.Group("/meetings")
 
 		meetings.Use(
-			middleware.JWT(cfg.JWTSecret),
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
 		)
 
-		meetings.GET("/by-date/:date", meetingHandler.GetMeetingByDate)
-		meetings.GET("/:id", meetingHandler.GetMeetingByID)
-		meetings.POST("", meetingHandler.CreateMeeting)
-		meetings.POST("/add-attendance", meetingHandler.AddUserAttendance)
-		meetings.POST("/finish-attendance", meetingHandler.FinishUserAttendance)
-
-		meetings.Use(middleware.AdminOnly())
-
-		meetings.DELETE("/delete/:id", meetingHandler.DeleteMeeting)
+		handle(meetings, []route{
+			{http.MethodGet, "", meetingController.GetMeetings, ""},
+			{http.MethodGet, "/by-date/:date", meetingController.GetMeetingByDate, ""},
+			{http.MethodGet, "/:id", meetingController.GetMeetingByID, ""},
+			{http.MethodPost, "/check-in", meetingController.CheckIn, ""},
+			{http.MethodPost, "/finish-attendance", meetingController.FinishUserAttendance, ""},
+			{http.MethodPost, "", meetingController.CreateMeeting, models.PermissionMeetingsWrite},
+			{http.MethodGet, "/deleted", meetingController.GetDeletedMeetings, models.PermissionMeetingsWrite},
+			{http.MethodPatch, "/:id", meetingController.UpdateMeeting, models.PermissionMeetingsWrite},
+			{http.MethodPost, "/:id/restore", meetingController.RestoreMeeting, models.PermissionMeetingsWrite},
+			{http.MethodDelete, "/delete/:id", meetingController.DeleteMeeting, models.PermissionMeetingsWrite},
+			{http.MethodPost, "/add-attendance", meetingController.AddUserAttendance, models.PermissionAttendanceManage},
+			{http.MethodGet, "/:id/code", meetingController.GetMeetingCode, models.PermissionAttendanceManage},
+			{http.MethodGet, "/:id/qrcode", meetingController.GetMeetingQRCode, models.PermissionAttendanceManage},
+			{http.MethodPost, "/:id/rotate-code", meetingController.RotateMeetingCode, models.PermissionAttendanceManage},
+			{http.MethodGet, "/export", exportController.ExportMeetings, models.PermissionReportsRead},
+			{http.MethodGet, "/:id/export", exportController.ExportMeeting, models.PermissionReportsRead},
+		})
 	}
 
 	{
+		userController := userHandler.NewUserController(svc.Users, svc.Auth, svc.Verification, svc.Invites, svc.TwoFactor)
+		twoFactorController := twoFactorHandler.NewTwoFactorController(svc.TwoFactor, svc.Users, svc.Auth)
+		verificationController := verificationHandler.NewVerificationController(svc.Verification)
+		certificateController := certificateHandler.NewCertificateController(svc.Certificates, svc.Users)
+		passwordController := passwordHandler.NewPasswordController(svc.Passwords)
 		users := router.Group("/users")
-		users.POST("/register", userHandler.CreateUser)
-		users.POST("/login", userHandler.LoginUser)
+		users.POST("/register", userController.CreateUser)
+		users.POST("/login", userController.LoginUser)
+		users.POST("/login/2fa", twoFactorController.LoginTwoFactor)
+		users.POST("/refresh", userController.RefreshToken)
+		users.POST("/password/forgot", passwordController.ForgotPassword)
+		users.POST("/password/reset", passwordController.ResetPassword)
+		users.POST("/email/verify", verificationController.VerifyEmail)
 
 		users.Use(
-			middleware.JWT(cfg.JWTSecret),
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
+		)
+
+		handle(users, []route{
+			{http.MethodPost, "/logout", userController.LogoutUser, ""},
+			{http.MethodGet, "/2fa", twoFactorController.GetTwoFactor, ""},
+			{http.MethodPost, "/2fa/enroll", twoFactorController.EnrollTwoFactor, ""},
+			{http.MethodPost, "/2fa/confirm", twoFactorController.ConfirmTwoFactor, ""},
+			{http.MethodPost, "/2fa/disable", twoFactorController.DisableTwoFactor, ""},
+			{http.MethodPost, "/2fa/recovery-codes", twoFactorController.RegenerateRecoveryCodes, ""},
+			{http.MethodGet, "/:id", userController.GetUserByID, ""},
+			{http.MethodGet, "/:id/certificate", certificateController.GetUserCertificate, ""},
+			{http.MethodPut, "/update/:id", userController.UpdateUser, ""},
+			{http.MethodGet, "/", userController.GetUsers, models.PermissionUsersRead},
+			{http.MethodGet, "/deleted", userController.GetDeletedUsers, models.PermissionUsersRead},
+			{http.MethodDelete, "/delete/:id", userController.DeleteUser, models.PermissionUsersManage},
+			{http.MethodPost, "/:id/restore", userController.RestoreUser, models.PermissionUsersManage},
+			{http.MethodPost, "/:id/email/verification", verificationController.ResendVerification, models.PermissionUsersManage},
+			{http.MethodPost, "/:id/email/verify", verificationController.ConfirmEmail, models.PermissionUsersManage},
+			{http.MethodPost, "/:id/roles", userController.AssignRole, models.PermissionRolesManage},
+			{http.MethodDelete, "/:id/roles/:role", userController.RevokeRole, models.PermissionRolesManage},
+			{http.MethodDelete, "/:id/2fa", twoFactorController.ResetTwoFactor, models.PermissionUsersManage},
+		})
+	}
+
+	{
+		certificateController := certificateHandler.NewCertificateController(svc.Certificates, svc.Users)
+		certificates := router.Group("/certificates")
+
+		certificates.GET("/verify/:code", certificateController.VerifyCertificate)
+	}
+
+	{
+		inviteController := inviteHandler.NewInviteController(svc.Invites)
+		invites := router.Group("/invites")
+
+		invites.Use(
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
+		)
+
+		handle(invites, []route{
+			{http.MethodGet, "", inviteController.GetInvites, models.PermissionInvitesManage},
+			{http.MethodPost, "", inviteController.CreateInvite, models.PermissionInvitesManage},
+			{http.MethodGet, "/:code", inviteController.GetInviteByCode, models.PermissionInvitesManage},
+			{http.MethodDelete, "/:code", inviteController.RevokeInvite, models.PermissionInvitesManage},
+		})
+	}
+
+	{
+		adminController := adminHandler.NewAdminController(svc.Purge)
+		admin := router.Group("/admin")
+
+		admin.Use(
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
+		)
+
+		handle(admin, []route{
+			{http.MethodPost, "/purge", adminController.Purge, models.PermissionSystemPurge},
+		})
+	}
+
+	{
+		reportController := reportHandler.NewReportController(svc.Reports, svc.Users)
+		reports := router.Group("/reports")
+
+		reports.Use(
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
+		)
+
+		handle(reports, []route{
+			{http.MethodGet, "/attendance", reportController.GetAttendanceReport, ""},
+		})
+	}
+
+	{
+		scheduleController := scheduleHandler.NewScheduleController(svc.Schedules)
+		schedules := router.Group("/schedules")
+
+		schedules.Use(
+			middleware.JWT(cfg.JWTSecret, svc.Auth),
 		)
 
-		users.GET("/:id", userHandler.GetUserByID)
-		users.DELETE("/delete/:id", userHandler.DeleteUser)
-		users.PUT("/update/:id", userHandler.UpdateUser)
+		handle(schedules, []route{
+			{http.MethodGet, "", scheduleController.GetSchedules, models.PermissionSchedulesManage},
+			{http.MethodPost, "", scheduleController.CreateSchedule, models.PermissionSchedulesManage},
+			{http.MethodPost, "/preview", scheduleController.PreviewSchedule, models.PermissionSchedulesManage},
+			{http.MethodGet, "/:id", scheduleController.GetScheduleByID, models.PermissionSchedulesManage},
+			{http.MethodGet, "/:id/preview", scheduleController.PreviewScheduleByID, models.PermissionSchedulesManage},
+			{http.MethodPost, "/:id/cancel", scheduleController.CancelOccurrence, models.PermissionSchedulesManage},
+			{http.MethodDelete, "/:id", scheduleController.DeleteSchedule, models.PermissionSchedulesManage},
+		})
+	}
+}
+
+// route binds a handler to a path of a group. An empty permission leaves the
+// route open to every authenticated user, handlers then check themselves
+// whether the caller may act on other users.
+type route struct {
+	method     string
+	path       string
+	handler    gin.HandlerFunc
+	permission models.Permission
+}
 
-		users.Use(middleware.AdminOnly())
+func handle(group *gin.RouterGroup, routes []route) {
+	for _, r := range routes {
+		if r.permission == "" {
+			group.Handle(r.method, r.path, r.handler)
+			continue
+		}
 
-		users.GET("/", userHandler.GetUsers)
+		group.Handle(r.method, r.path, middleware.RequirePermission(r.permission), r.handler)
 	}
 }
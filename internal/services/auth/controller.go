@@ -0,0 +1,65 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+)
+
+var (
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token already used, session revoked")
+)
+
+type AuthService struct {
+	repo        SessionRepository
+	userService *userServices.UserService
+	secret      string
+	accessTTL   time.Duration
+	refreshTTL  time.Duration
+
+	twoFactorRoles []string
+}
+
+// NewAuthService issues access tokens valid for accessTTL. Refresh tokens
+// are valid for refreshTTL after their last use. Users holding any of
+// twoFactorRoles get no permission from a login that skipped the second
+// factor.
+func NewAuthService(
+	repo SessionRepository,
+	userService *userServices.UserService,
+	secret string,
+	accessTTL, refreshTTL time.Duration,
+	twoFactorRoles []string,
+) *AuthService {
+	return &AuthService{
+		repo:           repo,
+		userService:    userService,
+		secret:         secret,
+		accessTTL:      accessTTL,
+		refreshTTL:     refreshTTL,
+		twoFactorRoles: twoFactorRoles,
+	}
+}
+
+// RequiresTwoFactor reports whether the roles of the user demand a second
+// factor.
+func (s *AuthService) RequiresTwoFactor(user *models.User) bool {
+	for _, role := range user.Roles {
+		if slices.Contains(s.twoFactorRoles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// @model TokenPair
+type TokenPair struct {
+	Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshToken string `json:"refresh_token" example:"mC4bq0u8H1m0c2dJ9xVqv3rS6yTn2wKf7pLz5aEoQeI"`
+	ExpiresIn    int    `json:"expires_in" example:"900"`
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FirestoreSessionRepository struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreSessionRepository(client *firestore.Client) *FirestoreSessionRepository {
+	return &FirestoreSessionRepository{
+		client:     client,
+		collection: client.Collection("sessions"),
+	}
+}
+
+func (r *FirestoreSessionRepository) Create(ctx context.Context, session models.Session) error {
+	_, err := r.collection.Doc(session.ID).Create(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreSessionRepository) GetByID(ctx context.Context, id string) (*models.Session, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	return decodeSession(doc)
+}
+
+// GetByTokenHash runs one query per field, Firestore has no OR across
+// fields without a composite filter index.
+func (r *FirestoreSessionRepository) GetByTokenHash(ctx context.Context, hash string) (*models.Session, error) {
+	for _, field := range []string{"tokenHash", "previousTokenHash"} {
+		docs, err := r.collection.Where(field, "==", hash).Limit(1).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to query session: %w", err)
+		}
+
+		if len(docs) > 0 {
+			return decodeSession(docs[0])
+		}
+	}
+
+	return nil, ErrSessionNotFound
+}
+
+func (r *FirestoreSessionRepository) Rotate(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error {
+	ref := r.collection.Doc(id)
+
+	return r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrSessionNotFound
+			}
+			return fmt.Errorf("failed to get session: %w", err)
+		}
+
+		session, err := decodeSession(doc)
+		if err != nil {
+			return err
+		}
+
+		if session.TokenHash != oldHash {
+			return ErrRefreshTokenReused
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "tokenHash", Value: newHash},
+			{Path: "previousTokenHash", Value: oldHash},
+			{Path: "lastUsedAt", Value: usedAt},
+			{Path: "expiresAt", Value: expiresAt},
+		})
+	})
+}
+
+func (r *FirestoreSessionRepository) Revoke(ctx context.Context, id string) error {
+	ref := r.collection.Doc(id)
+
+	return r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrSessionNotFound
+			}
+			return fmt.Errorf("failed to get session: %w", err)
+		}
+
+		session, err := decodeSession(doc)
+		if err != nil {
+			return err
+		}
+
+		if session.RevokedAt != nil {
+			return nil
+		}
+
+		return tx.Update(ref, []firestore.Update{{Path: "revokedAt", Value: time.Now()}})
+	})
+}
+
+func (r *FirestoreSessionRepository) RevokeAll(ctx context.Context, userID string) (int, error) {
+	iter := r.collection.
+		Where("userId", "==", userID).
+		Where("revokedAt", "==", nil).
+		Documents(ctx)
+	defer iter.Stop()
+
+	now := time.Now()
+	revoked := 0
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return revoked, nil
+		}
+		if err != nil {
+			return revoked, fmt.Errorf("failed to query sessions: %w", err)
+		}
+
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{{Path: "revokedAt", Value: now}}); err != nil {
+			return revoked, fmt.Errorf("failed to revoke session: %w", err)
+		}
+		revoked++
+	}
+}
+
+func (r *FirestoreSessionRepository) DeleteInactive(ctx context.Context, before time.Time) (int, error) {
+	deleted := 0
+	for _, field := range []string{"expiresAt", "revokedAt"} {
+		docs, err := r.collection.Where(field, "<", before).Documents(ctx).GetAll()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to query sessions: %w", err)
+		}
+
+		for _, doc := range docs {
+			if _, err := doc.Ref.Delete(ctx); err != nil {
+				return deleted, fmt.Errorf("failed to delete session: %w", err)
+			}
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func decodeSession(doc *firestore.DocumentSnapshot) (*models.Session, error) {
+	var session models.Session
+	if err := doc.DataTo(&session); err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+	session.ID = doc.Ref.ID
+
+	return &session, nil
+}
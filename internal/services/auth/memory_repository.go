@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MemorySessionRepository keeps sessions in process memory. It is meant for
+// local development and tests, all data is lost when the server stops.
+type MemorySessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]models.Session
+}
+
+func NewMemorySessionRepository() *MemorySessionRepository {
+	return &MemorySessionRepository{
+		sessions: make(map[string]models.Session),
+	}
+}
+
+func (r *MemorySessionRepository) Create(ctx context.Context, session models.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.ID] = session
+
+	return nil
+}
+
+func (r *MemorySessionRepository) GetByID(ctx context.Context, id string) (*models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	return &session, nil
+}
+
+func (r *MemorySessionRepository) GetByTokenHash(ctx context.Context, hash string) (*models.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, session := range r.sessions {
+		if session.TokenHash == hash || session.PreviousTokenHash == hash {
+			return &session, nil
+		}
+	}
+
+	return nil, ErrSessionNotFound
+}
+
+func (r *MemorySessionRepository) Rotate(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	if session.TokenHash != oldHash {
+		return ErrRefreshTokenReused
+	}
+
+	session.PreviousTokenHash = oldHash
+	session.TokenHash = newHash
+	session.LastUsedAt = usedAt
+	session.ExpiresAt = expiresAt
+	r.sessions[id] = session
+
+	return nil
+}
+
+func (r *MemorySessionRepository) Revoke(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	if session.RevokedAt == nil {
+		now := time.Now()
+		session.RevokedAt = &now
+		r.sessions[id] = session
+	}
+
+	return nil
+}
+
+func (r *MemorySessionRepository) RevokeAll(ctx context.Context, userID string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	revoked := 0
+	for id, session := range r.sessions {
+		if session.UserID != userID || session.RevokedAt != nil {
+			continue
+		}
+
+		session.RevokedAt = &now
+		r.sessions[id] = session
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+func (r *MemorySessionRepository) DeleteInactive(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, session := range r.sessions {
+		if session.ExpiresAt.Before(before) || (session.RevokedAt != nil && session.RevokedAt.Before(before)) {
+			delete(r.sessions, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// SessionRepository is the storage backend used by AuthService.
+// Implementations return ErrSessionNotFound when nothing matches.
+type SessionRepository interface {
+	Create(ctx context.Context, session models.Session) error
+	GetByID(ctx context.Context, id string) (*models.Session, error)
+
+	// GetByTokenHash matches hash against the current and the previous
+	// refresh token of the sessions.
+	GetByTokenHash(ctx context.Context, hash string) (*models.Session, error)
+
+	// Rotate replaces the refresh token of the session only while its
+	// current hash is still oldHash, so two refreshes racing with the same
+	// token cannot both succeed. A lost race returns ErrRefreshTokenReused.
+	Rotate(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error
+
+	Revoke(ctx context.Context, id string) error
+	RevokeAll(ctx context.Context, userID string) (int, error)
+
+	// DeleteInactive removes the sessions revoked or expired before the
+	// given time.
+	DeleteInactive(ctx context.Context, before time.Time) (int, error)
+}
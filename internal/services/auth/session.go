@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+// Login opens a session for the user, who must already be authenticated.
+// twoFactor tells whether the user also passed the second factor.
+func (s *AuthService) Login(ctx context.Context, user *models.User, twoFactor bool) (*TokenPair, error) {
+	refreshToken := util.GenerateToken()
+	now := time.Now()
+
+	session := models.Session{
+		ID:           util.GenerateID(),
+		UserID:       user.ID,
+		TokenVersion: user.TokenVersion,
+		TokenHash:    util.HashToken(refreshToken),
+		CreatedAt:    now,
+		LastUsedAt:   now,
+		ExpiresAt:    now.Add(s.refreshTTL),
+		TwoFactor:    twoFactor,
+	}
+
+	if err := s.repo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return s.tokenPair(&session, user, refreshToken)
+}
+
+// Refresh exchanges a refresh token for a new access token and a new
+// refresh token carrying the current roles of the user. Presenting a
+// refresh token that was already exchanged means it leaked, so the whole
+// session is revoked. Sessions opened before a role change are revoked too,
+// the user has to log in again.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	hash := util.HashToken(refreshToken)
+
+	session, err := s.repo.GetByTokenHash(ctx, hash)
+	if errors.Is(err, ErrSessionNotFound) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if session.RevokedAt != nil || !now.Before(session.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if session.TokenHash != hash {
+		return nil, s.revokeReused(ctx, session.ID)
+	}
+
+	user, err := s.userService.GetByID(ctx, session.UserID)
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		return nil, errors.Join(ErrInvalidRefreshToken, s.repo.Revoke(ctx, session.ID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TokenVersion != session.TokenVersion {
+		return nil, errors.Join(ErrInvalidRefreshToken, s.repo.Revoke(ctx, session.ID))
+	}
+
+	next := util.GenerateToken()
+	err = s.repo.Rotate(ctx, session.ID, hash, util.HashToken(next), now, now.Add(s.refreshTTL))
+	if errors.Is(err, ErrRefreshTokenReused) {
+		return nil, s.revokeReused(ctx, session.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tokenPair(session, user, next)
+}
+
+func (s *AuthService) revokeReused(ctx context.Context, sessionID string) error {
+	log.Printf("refresh token reused on session %s, revoking it", sessionID)
+
+	if err := s.repo.Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return ErrRefreshTokenReused
+}
+
+// Logout revokes a single session, its access tokens stop working right
+// away.
+func (s *AuthService) Logout(ctx context.Context, sessionID string) error {
+	return s.repo.Revoke(ctx, sessionID)
+}
+
+// LogoutAll revokes every session of the user and returns how many were
+// still active.
+func (s *AuthService) LogoutAll(ctx context.Context, userID string) (int, error) {
+	return s.repo.RevokeAll(ctx, userID)
+}
+
+// IsRevoked reports whether access tokens of the session must be refused.
+// Sessions that no longer exist count as revoked.
+func (s *AuthService) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	session, err := s.repo.GetByID(ctx, sessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return session.RevokedAt != nil, nil
+}
+
+// Run is the background job entry point, it removes sessions nobody can
+// use anymore.
+func (s *AuthService) Run(ctx context.Context) error {
+	deleted, err := s.repo.DeleteInactive(ctx, time.Now())
+	if deleted > 0 {
+		log.Printf("deleted %d inactive sessions", deleted)
+	}
+
+	return err
+}
+
+func (s *AuthService) tokenPair(session *models.Session, user *models.User, refreshToken string) (*TokenPair, error) {
+	token, err := util.GenerateJWT(models.Principal{
+		UserID:            user.ID,
+		SessionID:         session.ID,
+		Roles:             user.Roles,
+		TokenVersion:      user.TokenVersion,
+		TwoFactor:         session.TwoFactor,
+		TwoFactorRequired: !session.TwoFactor && s.RequiresTwoFactor(user),
+	}, s.secret, s.accessTTL)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate token: %w", err)
+	}
+
+	return &TokenPair{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(s.accessTTL.Seconds()),
+	}, nil
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+
+	"github.com/golang-jwt/jwt"
+)
+
+func newTestAuthService(t *testing.T, repo SessionRepository) (*AuthService, *userServices.UserService) {
+	t.Helper()
+
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+
+	return NewAuthService(repo, users, "test", 15*time.Minute, 24*time.Hour, nil), users
+}
+
+func newTestSQLRepository(t *testing.T) *SQLSessionRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLSessionRepository(db)
+}
+
+var testRepositories = map[string]func(t *testing.T) SessionRepository{
+	"memory": func(t *testing.T) SessionRepository { return NewMemorySessionRepository() },
+	"sqlite": func(t *testing.T) SessionRepository { return newTestSQLRepository(t) },
+}
+
+func TestRefresh(t *testing.T) {
+	for name, newRepo := range testRepositories {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			auth, users := newTestAuthService(t, repo)
+			ctx := context.Background()
+
+			user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			first, err := auth.Login(ctx, user, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			second, err := auth.Refresh(ctx, first.RefreshToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if second.RefreshToken == first.RefreshToken {
+				t.Error("Refresh() returned the same refresh token")
+			}
+
+			third, err := auth.Refresh(ctx, second.RefreshToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the second token was already exchanged, presenting it again
+			// revokes the whole session
+			if _, err := auth.Refresh(ctx, second.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+				t.Errorf("Refresh(reused token) error = %v, want ErrRefreshTokenReused", err)
+			}
+
+			if _, err := auth.Refresh(ctx, third.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+				t.Errorf("Refresh(after reuse) error = %v, want ErrInvalidRefreshToken", err)
+			}
+
+			if _, err := auth.Refresh(ctx, "unknown"); !errors.Is(err, ErrInvalidRefreshToken) {
+				t.Errorf("Refresh(unknown token) error = %v, want ErrInvalidRefreshToken", err)
+			}
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	for name, newRepo := range testRepositories {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			auth, users := newTestAuthService(t, repo)
+			ctx := context.Background()
+
+			user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var sessions []string
+			var tokens []string
+			for range 3 {
+				pair, err := auth.Login(ctx, user, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				session, err := repo.GetByTokenHash(ctx, util.HashToken(pair.RefreshToken))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				sessions = append(sessions, session.ID)
+				tokens = append(tokens, pair.RefreshToken)
+			}
+
+			if err := auth.Logout(ctx, sessions[0]); err != nil {
+				t.Fatal(err)
+			}
+
+			if revoked, err := auth.IsRevoked(ctx, sessions[0]); err != nil || !revoked {
+				t.Errorf("IsRevoked(logged out) = %v, %v, want true", revoked, err)
+			}
+
+			if revoked, err := auth.IsRevoked(ctx, sessions[1]); err != nil || revoked {
+				t.Errorf("IsRevoked(other session) = %v, %v, want false", revoked, err)
+			}
+
+			if _, err := auth.Refresh(ctx, tokens[0]); !errors.Is(err, ErrInvalidRefreshToken) {
+				t.Errorf("Refresh(logged out) error = %v, want ErrInvalidRefreshToken", err)
+			}
+
+			if revoked, err := auth.LogoutAll(ctx, user.ID); err != nil || revoked != 2 {
+				t.Errorf("LogoutAll() = %d, %v, want 2", revoked, err)
+			}
+
+			if revoked, err := auth.IsRevoked(ctx, "unknown"); err != nil || !revoked {
+				t.Errorf("IsRevoked(unknown) = %v, %v, want true", revoked, err)
+			}
+		})
+	}
+}
+
+func TestRefreshDeletedUser(t *testing.T) {
+	auth, users := newTestAuthService(t, NewMemorySessionRepository())
+	ctx := context.Background()
+
+	user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair, err := auth.Login(ctx, user, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := users.SoftDelete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := auth.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh(deleted user) error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestRefreshAfterRoleChange(t *testing.T) {
+	for name, newRepo := range testRepositories {
+		t.Run(name, func(t *testing.T) {
+			auth, users := newTestAuthService(t, newRepo(t))
+			ctx := context.Background()
+
+			user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pair, err := auth.Login(ctx, user, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := users.Update(ctx, user.ID, models.User{Roles: []string{"admin"}}); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := auth.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+				t.Errorf("Refresh(after role change) error = %v, want ErrInvalidRefreshToken", err)
+			}
+
+			user, err = users.GetByID(ctx, user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pair, err = auth.Login(ctx, user, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			refreshed, err := auth.Refresh(ctx, pair.RefreshToken)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var claims util.Claims
+			_, err = jwt.ParseWithClaims(refreshed.Token, &claims, func(*jwt.Token) (any, error) {
+				return []byte("test"), nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			principal := claims.Principal()
+
+			if !slices.Equal(principal.Roles, []string{"admin"}) || principal.TokenVersion != user.TokenVersion {
+				t.Errorf("principal = %+v, want the admin role at version %d", principal, user.TokenVersion)
+			}
+		})
+	}
+}
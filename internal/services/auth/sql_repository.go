@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+const sessionColumns = "id, user_id, token_version, token_hash, previous_token_hash, created_at, last_used_at, expires_at, " +
+	"revoked_at, two_factor"
+
+type SQLSessionRepository struct {
+	db *database.DB
+}
+
+func NewSQLSessionRepository(db *database.DB) *SQLSessionRepository {
+	return &SQLSessionRepository{db: db}
+}
+
+func (r *SQLSessionRepository) Create(ctx context.Context, session models.Session) error {
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO sessions ("+sessionColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL, ?)"),
+		session.ID, session.UserID, session.TokenVersion, session.TokenHash, session.PreviousTokenHash,
+		session.CreatedAt.UTC(), session.LastUsedAt.UTC(), session.ExpiresAt.UTC(), session.TwoFactor,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLSessionRepository) GetByID(ctx context.Context, id string) (*models.Session, error) {
+	return r.get(ctx, "id = ?", id)
+}
+
+func (r *SQLSessionRepository) GetByTokenHash(ctx context.Context, hash string) (*models.Session, error) {
+	return r.get(ctx, "token_hash = ? OR previous_token_hash = ?", hash, hash)
+}
+
+func (r *SQLSessionRepository) get(ctx context.Context, where string, args ...any) (*models.Session, error) {
+	var (
+		session   models.Session
+		revokedAt sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+sessionColumns+" FROM sessions WHERE "+where),
+		args...,
+	).Scan(
+		&session.ID, &session.UserID, &session.TokenVersion, &session.TokenHash, &session.PreviousTokenHash,
+		&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt, &revokedAt, &session.TwoFactor,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session: %w", err)
+	}
+
+	if revokedAt.Valid {
+		session.RevokedAt = &revokedAt.Time
+	}
+
+	return &session, nil
+}
+
+func (r *SQLSessionRepository) Rotate(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE sessions SET token_hash = ?, previous_token_hash = ?, last_used_at = ?, expires_at = ? "+
+			"WHERE id = ? AND token_hash = ?"),
+		newHash, oldHash, usedAt.UTC(), expiresAt.UTC(), id, oldHash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to rotate session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to rotate session: %w", err)
+	}
+
+	if affected == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return ErrRefreshTokenReused
+	}
+
+	return nil
+}
+
+func (r *SQLSessionRepository) Revoke(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE sessions SET revoked_at = COALESCE(revoked_at, ?) WHERE id = ?"),
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLSessionRepository) RevokeAll(ctx context.Context, userID string) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL"),
+		time.Now().UTC(), userID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+func (r *SQLSessionRepository) DeleteInactive(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("DELETE FROM sessions WHERE expires_at < ? OR revoked_at < ?"),
+		before.UTC(), before.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	return int(affected), nil
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+)
+
+var (
+	ErrCertificateNotFound  = errors.New("certificate not found")
+	ErrCertificateCodeTaken = errors.New("certificate code already taken")
+	ErrNoAttendance         = errors.New("no finished attendance in the period")
+	ErrInvalidDateRange     = errors.New("to must not be before from")
+)
+
+type CertificateService struct {
+	repo           CertificateRepository
+	meetingService *meetingServices.MeetingService
+	userService    *userServices.UserService
+	location       *time.Location
+	verifyURL      string
+}
+
+func NewCertificateService(
+	repo CertificateRepository,
+	meetingService *meetingServices.MeetingService,
+	userService *userServices.UserService,
+	location *time.Location,
+	verifyURL string,
+) *CertificateService {
+	return &CertificateService{
+		repo:           repo,
+		meetingService: meetingService,
+		userService:    userService,
+		location:       location,
+		verifyURL:      verifyURL,
+	}
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FirestoreCertificateRepository uses the code as document id, so Create
+// fails atomically when a code is reused.
+type FirestoreCertificateRepository struct {
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreCertificateRepository(client *firestore.Client) *FirestoreCertificateRepository {
+	return &FirestoreCertificateRepository{
+		collection: client.Collection("certificates"),
+	}
+}
+
+func (r *FirestoreCertificateRepository) Create(ctx context.Context, certificate models.Certificate) error {
+	_, err := r.collection.Doc(certificate.Code).Create(ctx, certificate)
+
+	if status.Code(err) == codes.AlreadyExists {
+		return ErrCertificateCodeTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreCertificateRepository) GetByCode(ctx context.Context, code string) (*models.Certificate, error) {
+	doc, err := r.collection.Doc(code).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrCertificateNotFound
+		}
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+
+	var certificate models.Certificate
+	if err := doc.DataTo(&certificate); err != nil {
+		return nil, fmt.Errorf("failed to decode certificate: %w", err)
+	}
+	certificate.Code = doc.Ref.ID
+
+	return &certificate, nil
+}
+
+func (r *FirestoreCertificateRepository) ListByUser(ctx context.Context, userID string) ([]models.Certificate, error) {
+	docs, err := r.collection.
+		Where("userId", "==", userID).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificates: %w", err)
+	}
+
+	certificates := make([]models.Certificate, 0, len(docs))
+	for _, doc := range docs {
+		var certificate models.Certificate
+		if err := doc.DataTo(&certificate); err != nil {
+			return nil, fmt.Errorf("failed to decode certificate: %w", err)
+		}
+		certificate.Code = doc.Ref.ID
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
+
+func (r *FirestoreCertificateRepository) Delete(ctx context.Context, code string) error {
+	_, err := r.collection.Doc(code).Delete(ctx, firestore.Exists)
+	if status.Code(err) == codes.NotFound {
+		return ErrCertificateNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	"nitelog/internal/util"
+)
+
+// CertifiedMeeting is a line of the certificate.
+type CertifiedMeeting struct {
+	Date     time.Time
+	Title    string
+	Duration time.Duration
+}
+
+// Issue records a certificate of the finished attendance of the user
+// between the normalized dates from and to. Without from the period starts
+// at the first meeting attended, without to it ends today. Attendance
+// closed at the cutoff is left out, its end time is a guess.
+func (s *CertificateService) Issue(ctx context.Context, userID string, from, to *time.Time) (*models.Certificate, []CertifiedMeeting, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, nil, ErrInvalidDateRange
+	}
+
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meetings, err := s.attended(ctx, user.Registration, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(meetings) == 0 {
+		return nil, nil, ErrNoAttendance
+	}
+
+	today, err := util.LocalDate(time.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to normalize date: %w", err)
+	}
+
+	certificate := models.Certificate{
+		UserID:       user.ID,
+		Name:         user.Name,
+		Registration: user.Registration,
+		From:         meetings[0].Date,
+		To:           *today,
+		Meetings:     len(meetings),
+		IssuedAt:     time.Now().UTC(),
+	}
+	if from != nil {
+		certificate.From = *from
+	}
+	if to != nil {
+		certificate.To = *to
+	}
+
+	for _, meeting := range meetings {
+		certificate.TotalSeconds += int64(meeting.Duration.Seconds())
+	}
+
+	for range 3 {
+		certificate.Code = util.GenerateCode(3)
+
+		err = s.repo.Create(ctx, certificate)
+		if !errors.Is(err, ErrCertificateCodeTaken) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &certificate, meetings, nil
+}
+
+func (s *CertificateService) attended(ctx context.Context, registration string, from, to *time.Time) ([]CertifiedMeeting, error) {
+	meetings := make([]CertifiedMeeting, 0)
+	opts := meetingServices.MeetingListOptions{
+		From:  from,
+		To:    to,
+		Limit: meetingServices.MaxMeetingPageSize,
+	}
+
+	for {
+		page, err := s.meetingService.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, meeting := range page.Meetings {
+			var duration time.Duration
+			for _, attendance := range meeting.Attendance {
+				if attendance.Registration != registration || attendance.EndTime == nil ||
+					attendance.AutoCloseReason == models.AutoCloseCutoff {
+					continue
+				}
+
+				duration += attendance.EndTime.Sub(attendance.StartTime)
+			}
+
+			if duration > 0 {
+				meetings = append(meetings, CertifiedMeeting{
+					Date:     meeting.Date,
+					Title:    meeting.Title,
+					Duration: duration.Round(time.Second),
+				})
+			}
+		}
+
+		if page.NextCursor == "" {
+			return meetings, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"sync"
+
+	"nitelog/internal/models"
+)
+
+// MemoryCertificateRepository keeps certificates in process memory. It is
+// meant for local development and tests, all data is lost when the server
+// stops.
+type MemoryCertificateRepository struct {
+	mu           sync.RWMutex
+	certificates map[string]models.Certificate
+}
+
+func NewMemoryCertificateRepository() *MemoryCertificateRepository {
+	return &MemoryCertificateRepository{
+		certificates: make(map[string]models.Certificate),
+	}
+}
+
+func (r *MemoryCertificateRepository) Create(ctx context.Context, certificate models.Certificate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.certificates[certificate.Code]; ok {
+		return ErrCertificateCodeTaken
+	}
+
+	r.certificates[certificate.Code] = certificate
+
+	return nil
+}
+
+func (r *MemoryCertificateRepository) GetByCode(ctx context.Context, code string) (*models.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	certificate, ok := r.certificates[code]
+	if !ok {
+		return nil, ErrCertificateNotFound
+	}
+
+	return &certificate, nil
+}
+
+func (r *MemoryCertificateRepository) ListByUser(ctx context.Context, userID string) ([]models.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var certificates []models.Certificate
+	for _, certificate := range r.certificates {
+		if certificate.UserID == userID {
+			certificates = append(certificates, certificate)
+		}
+	}
+
+	return certificates, nil
+}
+
+func (r *MemoryCertificateRepository) Delete(ctx context.Context, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.certificates[code]; !ok {
+		return ErrCertificateNotFound
+	}
+
+	delete(r.certificates, code)
+
+	return nil
+}
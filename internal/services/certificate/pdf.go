@@ -0,0 +1,82 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"nitelog/internal/models"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const dateLayout = "02/01/2006"
+
+// RenderPDF writes the certificate as an A4 PDF listing the meetings
+// attended. The core fonts only cover cp1252, which is enough for
+// Portuguese names.
+func (s *CertificateService) RenderPDF(w io.Writer, certificate *models.Certificate, meetings []CertifiedMeeting) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle("Certificado de participação", true)
+	pdf.SetAutoPageBreak(true, 25)
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-18)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.CellFormat(0, 4, tr(fmt.Sprintf(
+			"Código de verificação: %s - verifique em %s/%s",
+			certificate.Code, s.verifyURL, certificate.Code,
+		)), "", 1, "C", false, 0, "")
+		pdf.CellFormat(0, 4, tr(fmt.Sprintf("Página %d", pdf.PageNo())), "", 0, "C", false, 0, "")
+	})
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 14, tr("Certificado de participação"), "", 1, "C", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.SetFont("Helvetica", "", 12)
+	pdf.MultiCell(0, 7, tr(fmt.Sprintf(
+		"Certificamos que %s, matrícula %s, participou de %d reuniões do Nite "+
+			"entre %s e %s, totalizando %s horas de participação.",
+		certificate.Name,
+		certificate.Registration,
+		certificate.Meetings,
+		certificate.From.In(s.location).Format(dateLayout),
+		certificate.To.In(s.location).Format(dateLayout),
+		formatHours(time.Duration(certificate.TotalSeconds)*time.Second),
+	)), "", "J", false)
+	pdf.Ln(8)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.SetFillColor(230, 230, 230)
+	pdf.CellFormat(35, 8, "Data", "1", 0, "C", true, 0, "")
+	pdf.CellFormat(115, 8, tr("Reunião"), "1", 0, "L", true, 0, "")
+	pdf.CellFormat(30, 8, tr("Duração"), "1", 1, "C", true, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, meeting := range meetings {
+		title := meeting.Title
+		if title == "" {
+			title = "Reunião"
+		}
+
+		pdf.CellFormat(35, 7, meeting.Date.In(s.location).Format(dateLayout), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(115, 7, tr(title), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(30, 7, formatHours(meeting.Duration), "1", 1, "C", false, 0, "")
+	}
+
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, tr("Emitido em "+certificate.IssuedAt.In(s.location).Format("02/01/2006 15:04")), "", 1, "R", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+// formatHours formats d as hours and minutes, hours may go past 24.
+func formatHours(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
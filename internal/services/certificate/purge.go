@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"fmt"
+)
+
+// PurgeUser removes the certificates issued to the user, they carry a copy
+// of the name and registration that would otherwise outlive the account.
+// It returns how many were (or, on a dry run, would be) removed.
+func (s *CertificateService) PurgeUser(ctx context.Context, userID string, dryRun bool) (int, error) {
+	certificates, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if dryRun {
+		return len(certificates), nil
+	}
+
+	purged := 0
+	for _, certificate := range certificates {
+		if err := s.repo.Delete(ctx, certificate.Code); err != nil {
+			return purged, fmt.Errorf("failed to purge certificate %s: %w", certificate.Code, err)
+		}
+		purged++
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+
+	"nitelog/internal/models"
+)
+
+// CertificateRepository stores issued certificates so their codes can be
+// verified later. Implementations return ErrCertificateNotFound when no
+// certificate has the code.
+type CertificateRepository interface {
+	Create(ctx context.Context, certificate models.Certificate) error
+	GetByCode(ctx context.Context, code string) (*models.Certificate, error)
+	ListByUser(ctx context.Context, userID string) ([]models.Certificate, error)
+	Delete(ctx context.Context, code string) error
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+func newTestSQLRepository(t *testing.T) *SQLCertificateRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLCertificateRepository(db)
+}
+
+func TestCertificateRepository(t *testing.T) {
+	repos := map[string]func(t *testing.T) CertificateRepository{
+		"memory": func(t *testing.T) CertificateRepository { return NewMemoryCertificateRepository() },
+		"sqlite": func(t *testing.T) CertificateRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			ctx := context.Background()
+			from := time.Date(2025, time.August, 1, 3, 0, 0, 0, time.UTC)
+
+			certificate := models.Certificate{
+				Code:         "K7QX-M4TZ-9PWR",
+				UserID:       "user",
+				Name:         "Ana",
+				Registration: "1",
+				From:         from,
+				To:           from.AddDate(0, 1, 0),
+				Meetings:     3,
+				TotalSeconds: 10800,
+				IssuedAt:     from.AddDate(0, 1, 1),
+			}
+
+			if err := repo.Create(ctx, certificate); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := repo.Create(ctx, certificate); !errors.Is(err, ErrCertificateCodeTaken) {
+				t.Errorf("Create(same code) error = %v, want ErrCertificateCodeTaken", err)
+			}
+
+			stored, err := repo.GetByCode(ctx, certificate.Code)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if stored.Name != "Ana" || stored.TotalSeconds != 10800 || !stored.From.Equal(from) {
+				t.Errorf("GetByCode() = %+v, want %+v", stored, certificate)
+			}
+
+			if certificates, err := repo.ListByUser(ctx, "user"); err != nil || len(certificates) != 1 {
+				t.Errorf("ListByUser() = %v, %v, want the certificate", certificates, err)
+			}
+
+			if err := repo.Delete(ctx, certificate.Code); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := repo.GetByCode(ctx, certificate.Code); !errors.Is(err, ErrCertificateNotFound) {
+				t.Errorf("GetByCode(deleted) error = %v, want ErrCertificateNotFound", err)
+			}
+
+			if err := repo.Delete(ctx, certificate.Code); !errors.Is(err, ErrCertificateNotFound) {
+				t.Errorf("Delete(deleted) error = %v, want ErrCertificateNotFound", err)
+			}
+		})
+	}
+}
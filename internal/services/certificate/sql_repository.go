@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+const certificateColumns = "code, user_id, name, registration, period_from, period_to, meetings, total_seconds, issued_at"
+
+type SQLCertificateRepository struct {
+	db *database.DB
+}
+
+func NewSQLCertificateRepository(db *database.DB) *SQLCertificateRepository {
+	return &SQLCertificateRepository{db: db}
+}
+
+func (r *SQLCertificateRepository) Create(ctx context.Context, certificate models.Certificate) error {
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO certificates ("+certificateColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"),
+		certificate.Code, certificate.UserID, certificate.Name, certificate.Registration,
+		certificate.From.UTC(), certificate.To.UTC(), certificate.Meetings, certificate.TotalSeconds,
+		certificate.IssuedAt.UTC(),
+	)
+
+	if database.IsUniqueViolation(err, "certificates", "code") {
+		return ErrCertificateCodeTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLCertificateRepository) GetByCode(ctx context.Context, code string) (*models.Certificate, error) {
+	var certificate models.Certificate
+
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+certificateColumns+" FROM certificates WHERE code = ?"),
+		code,
+	).Scan(
+		&certificate.Code, &certificate.UserID, &certificate.Name, &certificate.Registration,
+		&certificate.From, &certificate.To, &certificate.Meetings, &certificate.TotalSeconds,
+		&certificate.IssuedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCertificateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificate: %w", err)
+	}
+
+	return &certificate, nil
+}
+
+func (r *SQLCertificateRepository) ListByUser(ctx context.Context, userID string) ([]models.Certificate, error) {
+	rows, err := r.db.QueryContext(ctx,
+		r.db.Rebind("SELECT "+certificateColumns+" FROM certificates WHERE user_id = ? ORDER BY issued_at"),
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificates: %w", err)
+	}
+	defer rows.Close()
+
+	var certificates []models.Certificate
+	for rows.Next() {
+		var certificate models.Certificate
+		err := rows.Scan(
+			&certificate.Code, &certificate.UserID, &certificate.Name, &certificate.Registration,
+			&certificate.From, &certificate.To, &certificate.Meetings, &certificate.TotalSeconds,
+			&certificate.IssuedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan certificate: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, rows.Err()
+}
+
+func (r *SQLCertificateRepository) Delete(ctx context.Context, code string) error {
+	result, err := r.db.ExecContext(ctx, r.db.Rebind("DELETE FROM certificates WHERE code = ?"), code)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCertificateNotFound
+	}
+
+	return nil
+}
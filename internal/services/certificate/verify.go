@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"nitelog/internal/models"
+)
+
+// Verify looks up a certificate by the code printed on it. The code is
+// accepted in lower case and with or without dashes.
+func (s *CertificateService) Verify(ctx context.Context, code string) (*models.Certificate, error) {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != 12 {
+		return nil, ErrCertificateNotFound
+	}
+
+	return s.repo.GetByCode(ctx, code[0:4]+"-"+code[4:8]+"-"+code[8:12])
+}
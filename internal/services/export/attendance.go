@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+)
+
+var sheetHeader = []string{
+	"Data", "Reunião", "Nome", "Matrícula", "Email", "Entrada", "Saída", "Duração", "Fechada automaticamente",
+}
+
+const localTimeLayout = "2006-01-02 15:04:05"
+
+// Meeting writes the attendance sheet of a single meeting. The meeting is
+// looked up before anything is written, so a missing one can still be
+// reported to the client.
+func (s *ExportService) Meeting(ctx context.Context, id, format string, w io.Writer) error {
+	meeting, err := s.meetingService.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	writer, err := s.newAttendanceWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.writeMeeting(ctx, meeting); err != nil {
+		return err
+	}
+
+	return writer.sheet.Close()
+}
+
+// Range writes the attendance sheet of every meeting between the
+// normalized dates from and to, inclusive. Meetings are read one page at a
+// time and written as soon as they are read.
+func (s *ExportService) Range(ctx context.Context, from, to *time.Time, format string, w io.Writer) error {
+	if from != nil && to != nil && to.Before(*from) {
+		return ErrInvalidDateRange
+	}
+
+	writer, err := s.newAttendanceWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	opts := meetingServices.MeetingListOptions{
+		From:  from,
+		To:    to,
+		Limit: meetingServices.MaxMeetingPageSize,
+	}
+
+	for {
+		page, err := s.meetingService.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		for i := range page.Meetings {
+			if err := writer.writeMeeting(ctx, &page.Meetings[i]); err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+
+	return writer.sheet.Close()
+}
+
+type attendanceWriter struct {
+	sheet       sheet
+	location    *time.Location
+	userService *userServices.UserService
+
+	// users caches the lookups by registration, nil marks a registration
+	// without a user
+	users map[string]*models.User
+}
+
+func (s *ExportService) newAttendanceWriter(format string, w io.Writer) (*attendanceWriter, error) {
+	sheet, err := newSheet(format, w)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sheet.WriteRow(sheetHeader); err != nil {
+		return nil, err
+	}
+
+	return &attendanceWriter{
+		sheet:       sheet,
+		location:    s.location,
+		userService: s.userService,
+		users:       make(map[string]*models.User),
+	}, nil
+}
+
+func (w *attendanceWriter) writeMeeting(ctx context.Context, meeting *models.Meeting) error {
+	date := meeting.Date.In(w.location).Format(time.DateOnly)
+
+	for _, attendance := range meeting.Attendance {
+		user, err := w.user(ctx, attendance.Registration)
+		if err != nil {
+			return err
+		}
+
+		var name, email string
+		if user != nil {
+			name, email = user.Name, user.Email
+		}
+
+		checkOut, duration, autoClosed := "", "", ""
+		if attendance.EndTime != nil {
+			checkOut = attendance.EndTime.In(w.location).Format(localTimeLayout)
+			duration = formatDuration(attendance.EndTime.Sub(attendance.StartTime))
+		}
+		if attendance.AutoClosed {
+			autoClosed = "sim"
+		}
+
+		err = w.sheet.WriteRow([]string{
+			date,
+			meeting.Title,
+			name,
+			attendance.Registration,
+			email,
+			attendance.StartTime.In(w.location).Format(localTimeLayout),
+			checkOut,
+			duration,
+			autoClosed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *attendanceWriter) user(ctx context.Context, registration string) (*models.User, error) {
+	if user, ok := w.users[registration]; ok {
+		return user, nil
+	}
+
+	user, err := w.userService.GetByRegistration(ctx, registration)
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		user, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	w.users[registration] = user
+	return user, nil
+}
+
+// formatDuration formats d as hours, minutes and seconds, hours may go past
+// 24.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
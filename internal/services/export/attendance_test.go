@@ -0,0 +1,78 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+)
+
+func TestMeetingCSV(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	meetingRepo := meetingServices.NewMemoryMeetingRepository()
+	meetings := meetingServices.NewMeetingService(meetingRepo, 30*time.Second, "")
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	exports := NewExportService(meetings, users, location)
+
+	if _, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash")); err != nil {
+		t.Fatal(err)
+	}
+
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+	meeting, err := meetings.Create(ctx, models.Meeting{Date: date, Title: "Reunião noturna"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2025, time.September, 3, 22, 0, 0, 0, time.UTC)
+	end := start.Add(2*time.Hour + 30*time.Minute)
+	err = meetingRepo.Update(ctx, meeting.ID, meetingServices.MeetingUpdate{Attendance: []models.Attendance{
+		{Registration: "1", StartTime: start, EndTime: &end, AutoClosed: true},
+		{Registration: "2", StartTime: start},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := exports.Meeting(ctx, meeting.ID, FormatCSV, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	body, ok := strings.CutPrefix(out.String(), "\uFEFF")
+	if !ok {
+		t.Error("CSV does not start with a byte order mark")
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{
+		sheetHeader,
+		{"2025-09-03", "Reunião noturna", "Ana", "1", "ana@example.com", "2025-09-03 19:00:00", "2025-09-03 21:30:00", "02:30:00", "sim"},
+		{"2025-09-03", "Reunião noturna", "", "2", "", "2025-09-03 19:00:00", "", "", ""},
+	}
+
+	if !slices.EqualFunc(rows, want, slices.Equal) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+
+	if err := exports.Meeting(ctx, meeting.ID, "pdf", &bytes.Buffer{}); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Meeting(pdf) error = %v, want ErrInvalidFormat", err)
+	}
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var (
+	ErrInvalidFormat    = errors.New("format must be csv or xlsx")
+	ErrInvalidDateRange = errors.New("to must not be before from")
+)
+
+type ExportService struct {
+	meetingService *meetingServices.MeetingService
+	userService    *userServices.UserService
+	location       *time.Location
+}
+
+func NewExportService(
+	meetingService *meetingServices.MeetingService,
+	userService *userServices.UserService,
+	location *time.Location,
+) *ExportService {
+	return &ExportService{
+		meetingService: meetingService,
+		userService:    userService,
+		location:       location,
+	}
+}
+
+// ContentType returns the MIME type of the files produced for format.
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv; charset=utf-8"
+}
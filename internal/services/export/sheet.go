@@ -0,0 +1,99 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// sheet writes the rows of an attendance sheet as they are produced.
+type sheet interface {
+	WriteRow(values []string) error
+	Close() error
+}
+
+func newSheet(format string, w io.Writer) (sheet, error) {
+	switch format {
+	case FormatCSV:
+		// the byte order mark makes spreadsheet programs read the file as
+		// UTF-8, names are full of accents
+		if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+			return nil, err
+		}
+
+		return &csvSheet{writer: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXSheet(w)
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+type csvSheet struct {
+	writer *csv.Writer
+}
+
+func (s *csvSheet) WriteRow(values []string) error {
+	return s.writer.Write(values)
+}
+
+func (s *csvSheet) Close() error {
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// xlsxSheet uses the excelize stream writer, rows are kept in a temporary
+// file instead of memory and copied to w on Close.
+type xlsxSheet struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	out    io.Writer
+	row    int
+}
+
+const xlsxSheetName = "Presenças"
+
+func newXLSXSheet(w io.Writer) (*xlsxSheet, error) {
+	file := excelize.NewFile()
+
+	if err := file.SetSheetName("Sheet1", xlsxSheetName); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	stream, err := file.NewStreamWriter(xlsxSheetName)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxSheet{file: file, stream: stream, out: w}, nil
+}
+
+func (s *xlsxSheet) WriteRow(values []string) error {
+	s.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, s.row)
+	if err != nil {
+		return err
+	}
+
+	row := make([]any, len(values))
+	for i, value := range values {
+		row[i] = value
+	}
+
+	return s.stream.SetRow(cell, row)
+}
+
+func (s *xlsxSheet) Close() error {
+	defer s.file.Close()
+
+	if err := s.stream.Flush(); err != nil {
+		return fmt.Errorf("failed to flush sheet: %w", err)
+	}
+
+	return s.file.Write(s.out)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInviteNotFound        = errors.New("invite not found")
+	ErrInviteCodeTaken       = errors.New("invite code already taken")
+	ErrInvalidInvite         = errors.New("invalid, expired or exhausted invite code")
+	ErrInviteRequired        = errors.New("registration requires an invite code")
+	ErrEmailDomainNotAllowed = errors.New("email domain not allowed to register")
+	ErrInvalidExpiration     = errors.New("expiration must be in the future")
+)
+
+type InviteService struct {
+	repo    InviteRepository
+	policy  string
+	domains []string
+	ttl     time.Duration
+}
+
+// NewInviteService enforces the registration policy, one of the
+// config.Registration* values. domains are the email domains admitted by
+// config.RegistrationDomain, invites expire after ttl unless told otherwise.
+func NewInviteService(repo InviteRepository, policy string, domains []string, ttl time.Duration) *InviteService {
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+		if domain != "" {
+			normalized = append(normalized, domain)
+		}
+	}
+
+	return &InviteService{
+		repo:    repo,
+		policy:  policy,
+		domains: normalized,
+		ttl:     ttl,
+	}
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FirestoreInviteRepository uses the code as document id, so Create fails
+// atomically when a code is reused.
+type FirestoreInviteRepository struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreInviteRepository(client *firestore.Client) *FirestoreInviteRepository {
+	return &FirestoreInviteRepository{
+		client:     client,
+		collection: client.Collection("invites"),
+	}
+}
+
+func (r *FirestoreInviteRepository) Create(ctx context.Context, invite models.Invite) error {
+	_, err := r.collection.Doc(invite.Code).Create(ctx, invite)
+
+	if status.Code(err) == codes.AlreadyExists {
+		return ErrInviteCodeTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create invite: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreInviteRepository) GetByCode(ctx context.Context, code string) (*models.Invite, error) {
+	doc, err := r.collection.Doc(code).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrInviteNotFound
+		}
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	return decodeInvite(doc)
+}
+
+func (r *FirestoreInviteRepository) List(ctx context.Context) ([]models.Invite, error) {
+	docs, err := r.collection.OrderBy("createdAt", firestore.Desc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invites: %w", err)
+	}
+
+	invites := make([]models.Invite, 0, len(docs))
+	for _, doc := range docs {
+		invite, err := decodeInvite(doc)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, *invite)
+	}
+
+	return invites, nil
+}
+
+func (r *FirestoreInviteRepository) Redeem(ctx context.Context, code string, now time.Time) error {
+	return r.update(ctx, code, func(invite *models.Invite) ([]firestore.Update, error) {
+		if !invite.Usable(now) {
+			return nil, ErrInvalidInvite
+		}
+		return []firestore.Update{{Path: "uses", Value: firestore.Increment(1)}}, nil
+	}, ErrInvalidInvite)
+}
+
+func (r *FirestoreInviteRepository) Release(ctx context.Context, code string) error {
+	return r.update(ctx, code, func(invite *models.Invite) ([]firestore.Update, error) {
+		if invite.Uses == 0 {
+			return nil, nil
+		}
+		return []firestore.Update{{Path: "uses", Value: firestore.Increment(-1)}}, nil
+	}, ErrInviteNotFound)
+}
+
+func (r *FirestoreInviteRepository) Revoke(ctx context.Context, code string, revokedAt time.Time) error {
+	return r.update(ctx, code, func(invite *models.Invite) ([]firestore.Update, error) {
+		if invite.RevokedAt != nil {
+			return nil, nil
+		}
+		return []firestore.Update{{Path: "revokedAt", Value: revokedAt}}, nil
+	}, ErrInviteNotFound)
+}
+
+// update applies the changes returned by change in a transaction, a missing
+// invite fails with notFound.
+func (r *FirestoreInviteRepository) update(
+	ctx context.Context,
+	code string,
+	change func(invite *models.Invite) ([]firestore.Update, error),
+	notFound error,
+) error {
+	ref := r.collection.Doc(code)
+
+	return r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return notFound
+			}
+			return fmt.Errorf("failed to get invite: %w", err)
+		}
+
+		invite, err := decodeInvite(doc)
+		if err != nil {
+			return err
+		}
+
+		updates, err := change(invite)
+		if err != nil || len(updates) == 0 {
+			return err
+		}
+
+		return tx.Update(ref, updates)
+	})
+}
+
+func decodeInvite(doc *firestore.DocumentSnapshot) (*models.Invite, error) {
+	var invite models.Invite
+	if err := doc.DataTo(&invite); err != nil {
+		return nil, fmt.Errorf("failed to decode invite: %w", err)
+	}
+	invite.Code = doc.Ref.ID
+
+	return &invite, nil
+}
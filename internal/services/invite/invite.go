@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"nitelog/internal/config"
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+type CreateInviteOptions struct {
+	Note      string
+	MaxUses   int
+	ExpiresAt *time.Time
+	CreatedBy string
+}
+
+func (s *InviteService) Create(ctx context.Context, opts CreateInviteOptions) (*models.Invite, error) {
+	now := time.Now().UTC()
+
+	invite := models.Invite{
+		Note:      opts.Note,
+		MaxUses:   opts.MaxUses,
+		CreatedBy: opts.CreatedBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+	if opts.ExpiresAt != nil {
+		if !opts.ExpiresAt.After(now) {
+			return nil, ErrInvalidExpiration
+		}
+		invite.ExpiresAt = opts.ExpiresAt.UTC()
+	}
+
+	var err error
+	for range 3 {
+		invite.Code = util.GenerateCode(2)
+
+		err = s.repo.Create(ctx, invite)
+		if !errors.Is(err, ErrInviteCodeTaken) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+func (s *InviteService) GetByCode(ctx context.Context, code string) (*models.Invite, error) {
+	return s.repo.GetByCode(ctx, normalizeCode(code))
+}
+
+func (s *InviteService) List(ctx context.Context) ([]models.Invite, error) {
+	return s.repo.List(ctx)
+}
+
+// Revoke stops the invite from admitting anyone else, users who already
+// registered with it are kept.
+func (s *InviteService) Revoke(ctx context.Context, code string) (*models.Invite, error) {
+	code = normalizeCode(code)
+
+	if err := s.repo.Revoke(ctx, code, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByCode(ctx, code)
+}
+
+// Admit checks whether email may register under the registration policy.
+// A valid invite code admits any email under every policy, one use of it
+// is counted and its normalized code returned so the caller can Release
+// it when the account is not created after all.
+func (s *InviteService) Admit(ctx context.Context, email, code string) (string, error) {
+	if code != "" {
+		code = normalizeCode(code)
+
+		err := s.repo.Redeem(ctx, code, time.Now())
+		if errors.Is(err, ErrInviteNotFound) {
+			return "", ErrInvalidInvite
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return code, nil
+	}
+
+	switch s.policy {
+	case config.RegistrationInvite:
+		return "", ErrInviteRequired
+	case config.RegistrationDomain:
+		if !s.domainAllowed(email) {
+			return "", ErrEmailDomainNotAllowed
+		}
+	}
+
+	return "", nil
+}
+
+// AllowEmailChange checks whether a registered user may switch to email.
+// Invites only count at registration, so under the domain policy the new
+// email has to be in an allowed domain like any other.
+func (s *InviteService) AllowEmailChange(email string) error {
+	if s.policy == config.RegistrationDomain && !s.domainAllowed(email) {
+		return ErrEmailDomainNotAllowed
+	}
+
+	return nil
+}
+
+// Release gives back the invite use counted by Admit.
+func (s *InviteService) Release(ctx context.Context, code string) error {
+	if code == "" {
+		return nil
+	}
+
+	return s.repo.Release(ctx, code)
+}
+
+// domainAllowed accepts the listed domains and their subdomains. Anything
+// but a single bare address is refused, so the domain cannot be smuggled in
+// after another address.
+func (s *InviteService) domainAllowed(email string) bool {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" || address.Address != email {
+		return false
+	}
+
+	at := strings.LastIndex(address.Address, "@")
+	domain := strings.ToLower(address.Address[at+1:])
+
+	for _, allowed := range s.domains {
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeCode accepts codes typed in lower case or with stray spaces.
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
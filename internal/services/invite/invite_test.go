@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"nitelog/internal/config"
+	"nitelog/internal/database"
+)
+
+func newTestSQLRepository(t *testing.T) *SQLInviteRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLInviteRepository(db)
+}
+
+func TestAdmitInvite(t *testing.T) {
+	repos := map[string]func(t *testing.T) InviteRepository{
+		"memory": func(t *testing.T) InviteRepository { return NewMemoryInviteRepository() },
+		"sqlite": func(t *testing.T) InviteRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			invites := NewInviteService(newRepo(t), config.RegistrationInvite, nil, time.Hour)
+			ctx := context.Background()
+
+			invite, err := invites.Create(ctx, CreateInviteOptions{MaxUses: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := invites.Admit(ctx, "ana@example.com", ""); !errors.Is(err, ErrInviteRequired) {
+				t.Errorf("Admit(no code) error = %v, want ErrInviteRequired", err)
+			}
+
+			code, err := invites.Admit(ctx, "ana@example.com", " "+strings.ToLower(invite.Code)+" ")
+			if err != nil || code != invite.Code {
+				t.Fatalf("Admit() = %q, %v, want %q", code, err, invite.Code)
+			}
+
+			if _, err := invites.Admit(ctx, "bruno@example.com", invite.Code); !errors.Is(err, ErrInvalidInvite) {
+				t.Errorf("Admit(exhausted) error = %v, want ErrInvalidInvite", err)
+			}
+
+			// the first account was not created after all, its use is given
+			// back
+			if err := invites.Release(ctx, code); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := invites.Admit(ctx, "bruno@example.com", invite.Code); err != nil {
+				t.Errorf("Admit(released) error = %v, want nil", err)
+			}
+
+			stored, err := invites.GetByCode(ctx, invite.Code)
+			if err != nil || stored.Uses != 1 {
+				t.Errorf("GetByCode() = %+v, %v, want one use", stored, err)
+			}
+
+			revoked, err := invites.Create(ctx, CreateInviteOptions{MaxUses: 5})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := invites.Revoke(ctx, revoked.Code); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := invites.Admit(ctx, "carla@example.com", revoked.Code); !errors.Is(err, ErrInvalidInvite) {
+				t.Errorf("Admit(revoked) error = %v, want ErrInvalidInvite", err)
+			}
+
+			if _, err := invites.Admit(ctx, "carla@example.com", "AAAA-BBBB"); !errors.Is(err, ErrInvalidInvite) {
+				t.Errorf("Admit(unknown) error = %v, want ErrInvalidInvite", err)
+			}
+		})
+	}
+}
+
+func TestAdmitDomain(t *testing.T) {
+	invites := NewInviteService(NewMemoryInviteRepository(), config.RegistrationDomain, []string{"@UFSC.br"}, time.Hour)
+	ctx := context.Background()
+
+	tests := []struct {
+		email string
+		want  error
+	}{
+		{"ana@ufsc.br", nil},
+		{"ana@UFSC.BR", nil},
+		{"ana@inf.ufsc.br", nil},
+		{"ana@example.com", ErrEmailDomainNotAllowed},
+		{"ana@notufsc.br", ErrEmailDomainNotAllowed},
+		{"ana@ufsc.br.example.com", ErrEmailDomainNotAllowed},
+		{"Ana <ana@ufsc.br>", ErrEmailDomainNotAllowed},
+		{"ana@example.com, bruno@ufsc.br", ErrEmailDomainNotAllowed},
+		{"ana", ErrEmailDomainNotAllowed},
+	}
+
+	for _, tt := range tests {
+		if _, err := invites.Admit(ctx, tt.email, ""); !errors.Is(err, tt.want) {
+			t.Errorf("Admit(%q) error = %v, want %v", tt.email, err, tt.want)
+		}
+
+		if err := invites.AllowEmailChange(tt.email); !errors.Is(err, tt.want) {
+			t.Errorf("AllowEmailChange(%q) error = %v, want %v", tt.email, err, tt.want)
+		}
+	}
+
+	// an invite admits any email under every policy
+	invite, err := invites.Create(ctx, CreateInviteOptions{MaxUses: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := invites.Admit(ctx, "ana@example.com", invite.Code); err != nil {
+		t.Errorf("Admit(with invite) error = %v, want nil", err)
+	}
+}
+
+func TestAllowEmailChangeOpen(t *testing.T) {
+	invites := NewInviteService(NewMemoryInviteRepository(), config.RegistrationOpen, nil, time.Hour)
+
+	if err := invites.AllowEmailChange("ana@example.com"); err != nil {
+		t.Errorf("AllowEmailChange() error = %v, want nil", err)
+	}
+}
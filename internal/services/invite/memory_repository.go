@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MemoryInviteRepository keeps invites in process memory. It is meant for
+// local development and tests, all data is lost when the server stops.
+type MemoryInviteRepository struct {
+	mu      sync.RWMutex
+	invites map[string]models.Invite
+}
+
+func NewMemoryInviteRepository() *MemoryInviteRepository {
+	return &MemoryInviteRepository{
+		invites: make(map[string]models.Invite),
+	}
+}
+
+func (r *MemoryInviteRepository) Create(ctx context.Context, invite models.Invite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.invites[invite.Code]; ok {
+		return ErrInviteCodeTaken
+	}
+
+	r.invites[invite.Code] = invite
+
+	return nil
+}
+
+func (r *MemoryInviteRepository) GetByCode(ctx context.Context, code string) (*models.Invite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invite, ok := r.invites[code]
+	if !ok {
+		return nil, ErrInviteNotFound
+	}
+
+	return &invite, nil
+}
+
+func (r *MemoryInviteRepository) List(ctx context.Context) ([]models.Invite, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invites := make([]models.Invite, 0, len(r.invites))
+	for _, invite := range r.invites {
+		invites = append(invites, invite)
+	}
+
+	slices.SortFunc(invites, func(a, b models.Invite) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return invites, nil
+}
+
+func (r *MemoryInviteRepository) Redeem(ctx context.Context, code string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invite, ok := r.invites[code]
+	if !ok || !invite.Usable(now) {
+		return ErrInvalidInvite
+	}
+
+	invite.Uses++
+	r.invites[code] = invite
+
+	return nil
+}
+
+func (r *MemoryInviteRepository) Release(ctx context.Context, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invite, ok := r.invites[code]
+	if !ok {
+		return ErrInviteNotFound
+	}
+
+	if invite.Uses > 0 {
+		invite.Uses--
+		r.invites[code] = invite
+	}
+
+	return nil
+}
+
+func (r *MemoryInviteRepository) Revoke(ctx context.Context, code string, revokedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invite, ok := r.invites[code]
+	if !ok {
+		return ErrInviteNotFound
+	}
+
+	if invite.RevokedAt == nil {
+		invite.RevokedAt = &revokedAt
+		r.invites[code] = invite
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// InviteRepository is the storage backend used by InviteService.
+// Implementations return ErrInviteNotFound when no invite has the code.
+type InviteRepository interface {
+	// Create fails with ErrInviteCodeTaken when the code is already used.
+	Create(ctx context.Context, invite models.Invite) error
+	GetByCode(ctx context.Context, code string) (*models.Invite, error)
+
+	// List returns every invite, newest first.
+	List(ctx context.Context) ([]models.Invite, error)
+
+	// Redeem counts one use of the invite only while it is usable at now,
+	// so concurrent registrations cannot exceed the limit. An invite that
+	// is not usable returns ErrInvalidInvite.
+	Redeem(ctx context.Context, code string, now time.Time) error
+
+	// Release gives back a use counted by Redeem.
+	Release(ctx context.Context, code string) error
+
+	Revoke(ctx context.Context, code string, revokedAt time.Time) error
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+const inviteColumns = "code, note, max_uses, uses, created_by, created_at, expires_at, revoked_at"
+
+type SQLInviteRepository struct {
+	db *database.DB
+}
+
+func NewSQLInviteRepository(db *database.DB) *SQLInviteRepository {
+	return &SQLInviteRepository{db: db}
+}
+
+func (r *SQLInviteRepository) Create(ctx context.Context, invite models.Invite) error {
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO invites ("+inviteColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, NULL)"),
+		invite.Code, invite.Note, invite.MaxUses, invite.Uses, invite.CreatedBy,
+		invite.CreatedAt.UTC(), invite.ExpiresAt.UTC(),
+	)
+
+	if database.IsUniqueViolation(err, "invites", "code") {
+		return ErrInviteCodeTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create invite: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLInviteRepository) GetByCode(ctx context.Context, code string) (*models.Invite, error) {
+	invite, err := scanInvite(r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+inviteColumns+" FROM invites WHERE code = ?"),
+		code,
+	))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInviteNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+func (r *SQLInviteRepository) List(ctx context.Context) ([]models.Invite, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+inviteColumns+" FROM invites ORDER BY created_at DESC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invites: %w", err)
+	}
+	defer rows.Close()
+
+	invites := make([]models.Invite, 0)
+	for rows.Next() {
+		invite, err := scanInvite(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invite: %w", err)
+		}
+		invites = append(invites, *invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+func (r *SQLInviteRepository) Redeem(ctx context.Context, code string, now time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE invites SET uses = uses + 1 "+
+			"WHERE code = ? AND revoked_at IS NULL AND expires_at > ? AND uses < max_uses"),
+		code, now.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to redeem invite: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to redeem invite: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrInvalidInvite
+	}
+
+	return nil
+}
+
+func (r *SQLInviteRepository) Release(ctx context.Context, code string) error {
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE invites SET uses = uses - 1 WHERE code = ? AND uses > 0"),
+		code,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release invite: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLInviteRepository) Revoke(ctx context.Context, code string, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE invites SET revoked_at = COALESCE(revoked_at, ?) WHERE code = ?"),
+		revokedAt.UTC(), code,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrInviteNotFound
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanInvite(row rowScanner) (*models.Invite, error) {
+	var (
+		invite    models.Invite
+		revokedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&invite.Code, &invite.Note, &invite.MaxUses, &invite.Uses, &invite.CreatedBy,
+		&invite.CreatedAt, &invite.ExpiresAt, &revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		invite.RevokedAt = &revokedAt.Time
+	}
+
+	return &invite, nil
+}
@@ -2,34 +2,21 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"nitelog/internal/models"
-
-	"cloud.google.com/go/firestore"
 )
 
-func (s *MeetingService) AddAttendance(ctx context.Context, date time.Time, registration string) error {
-	query := s.collection.
-		Where("date", "==", date).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	docs, err := query.Documents(ctx).GetAll()
+func (s *MeetingService) AddAttendance(ctx context.Context, ref MeetingRef, registration string) error {
+	meeting, err := s.Resolve(ctx, ref)
 	if err != nil {
-		return fmt.Errorf("failed to find meeting: %w", err)
-	}
-	if len(docs) == 0 {
-		return ErrMeetingNotFound
-	}
-
-	docRef := docs[0].Ref
-	var meeting models.Meeting
-	if err := docs[0].DataTo(&meeting); err != nil {
 		return err
 	}
 
+	return s.addAttendance(ctx, meeting, registration)
+}
+
+func (s *MeetingService) addAttendance(ctx context.Context, meeting *models.Meeting, registration string) error {
 	for _, attendance := range meeting.Attendance {
 		if attendance.Registration == registration {
 			return ErrActiveAttendanceExists
@@ -43,16 +30,7 @@ func (s *MeetingService) AddAttendance(ctx context.Context, date time.Time, regi
 	}
 	meeting.Attendance = append(meeting.Attendance, newAttendance)
 
-	_, err = docRef.Update(ctx, []firestore.Update{
-		{
-			Path:  "attendance",
-			Value: meeting.Attendance,
-		},
-		{
-			Path:  "updatedAt",
-			Value: firestore.ServerTimestamp,
-		},
+	return s.repo.Update(ctx, meeting.ID, MeetingUpdate{
+		Attendance: meeting.Attendance,
 	})
-
-	return err
 }
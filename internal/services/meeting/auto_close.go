@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// AutoCloser finishes the attendances people forgot to finish. Attendance
+// of a meeting with a scheduled end is closed at that end, any other is
+// closed at the cutoff, a time of day after the midnight following the
+// meeting date.
+type AutoCloser struct {
+	meetingService *MeetingService
+	cutoff         time.Duration
+	lookback       int
+	location       *time.Location
+}
+
+// NewAutoCloser checks the meetings of the last lookbackDays days, older
+// ones are expected to have been closed by earlier runs. The cutoff is
+// counted from midnight in location.
+func NewAutoCloser(meetingService *MeetingService, cutoff time.Duration, lookbackDays int, location *time.Location) *AutoCloser {
+	return &AutoCloser{
+		meetingService: meetingService,
+		cutoff:         cutoff,
+		lookback:       lookbackDays,
+		location:       location,
+	}
+}
+
+// Run is the background job entry point.
+func (a *AutoCloser) Run(ctx context.Context) error {
+	closed, err := a.Close(ctx, time.Now())
+	if closed > 0 {
+		log.Printf("auto-closed %d attendances", closed)
+	}
+
+	return err
+}
+
+// Close finishes every open attendance due by now and returns how many were
+// closed.
+func (a *AutoCloser) Close(ctx context.Context, now time.Time) (int, error) {
+	year, month, day := now.In(a.location).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, a.location)
+	from := today.AddDate(0, 0, -a.lookback).UTC()
+	to := today.UTC()
+
+	opts := MeetingListOptions{From: &from, To: &to, Limit: MaxMeetingPageSize}
+
+	closed := 0
+	var errs []error
+	for {
+		page, err := a.meetingService.List(ctx, opts)
+		if err != nil {
+			return closed, errors.Join(append(errs, err)...)
+		}
+
+		for i := range page.Meetings {
+			meeting := &page.Meetings[i]
+			closes := a.closeDue(meeting, now)
+			if len(closes) == 0 {
+				continue
+			}
+
+			count, err := a.meetingService.repo.CloseAttendance(ctx, meeting.ID, closes)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to close attendance of meeting %s: %w", meeting.ID, err))
+				continue
+			}
+			closed += count
+		}
+
+		if page.NextCursor == "" {
+			return closed, errors.Join(errs...)
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+// closeDue returns how to close the open attendances of the meeting that
+// are due by now. The meeting may be stale, CloseAttendance only applies the
+// closes to entries that are still open.
+func (a *AutoCloser) closeDue(meeting *models.Meeting, now time.Time) []AttendanceClose {
+	year, month, day := meeting.Date.In(a.location).Date()
+	cutoff := time.Date(year, month, day+1, 0, 0, 0, 0, a.location).Add(a.cutoff)
+
+	var closes []AttendanceClose
+	for i, attendance := range meeting.Attendance {
+		if attendance.EndTime != nil {
+			continue
+		}
+
+		endTime, reason := cutoff, models.AutoCloseCutoff
+		if meeting.EndsAt != nil && attendance.StartTime.Before(*meeting.EndsAt) {
+			endTime, reason = *meeting.EndsAt, models.AutoCloseMeetingEnded
+		}
+
+		if now.Before(endTime) {
+			continue
+		}
+
+		if endTime.Before(attendance.StartTime) {
+			endTime = attendance.StartTime
+		}
+
+		closes = append(closes, AttendanceClose{
+			Position:     i,
+			Registration: attendance.Registration,
+			EndTime:      endTime,
+			Reason:       reason,
+		})
+	}
+
+	return closes
+}
+
+// applyAttendanceCloses returns a copy of attendance with closes applied to
+// the entries that are still open and still belong to the registration of
+// the close, and how many were.
+func applyAttendanceCloses(attendance []models.Attendance, closes []AttendanceClose) ([]models.Attendance, int) {
+	attendance = slices.Clone(attendance)
+
+	closed := 0
+	for _, pending := range closes {
+		if pending.Position < 0 || pending.Position >= len(attendance) {
+			continue
+		}
+
+		entry := &attendance[pending.Position]
+		if entry.Registration != pending.Registration || entry.EndTime != nil {
+			continue
+		}
+
+		endTime := pending.EndTime
+		entry.EndTime = &endTime
+		entry.AutoClosed = true
+		entry.AutoCloseReason = pending.Reason
+		closed++
+	}
+
+	return attendance, closed
+}
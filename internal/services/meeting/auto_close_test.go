@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+var testLocation = time.FixedZone("BRT", -3*60*60)
+
+// local returns the time on 2025-09-03, the test meeting date, or on the
+// following days when hour goes past 24.
+func local(hour, minute int) time.Time {
+	return time.Date(2025, time.September, 3, hour, minute, 0, 0, testLocation)
+}
+
+func TestCloseDue(t *testing.T) {
+	closer := &AutoCloser{cutoff: 4 * time.Hour, location: testLocation}
+	endsAt := local(22, 0)
+	// the meeting date is midnight in the configured timezone, stored in UTC
+	date := local(0, 0).UTC()
+
+	tests := []struct {
+		name      string
+		endsAt    *time.Time
+		start     time.Time
+		finished  bool
+		now       time.Time
+		wantEnd   time.Time
+		wantClose string
+	}{
+		{
+			name:     "already finished",
+			start:    local(19, 0),
+			finished: true,
+			now:      local(30, 0),
+		},
+		{
+			name:  "before cutoff",
+			start: local(19, 0),
+			now:   local(27, 59),
+		},
+		{
+			name:      "at cutoff",
+			start:     local(19, 0),
+			now:       local(28, 0),
+			wantEnd:   local(28, 0),
+			wantClose: models.AutoCloseCutoff,
+		},
+		{
+			name:   "before meeting end",
+			endsAt: &endsAt,
+			start:  local(19, 0),
+			now:    local(21, 59),
+		},
+		{
+			name:      "after meeting end",
+			endsAt:    &endsAt,
+			start:     local(19, 0),
+			now:       local(22, 30),
+			wantEnd:   endsAt,
+			wantClose: models.AutoCloseMeetingEnded,
+		},
+		{
+			name:   "started after meeting end waits for cutoff",
+			endsAt: &endsAt,
+			start:  local(22, 30),
+			now:    local(23, 0),
+		},
+		{
+			name:      "started after meeting end closes at cutoff",
+			endsAt:    &endsAt,
+			start:     local(22, 30),
+			now:       local(28, 0),
+			wantEnd:   local(28, 0),
+			wantClose: models.AutoCloseCutoff,
+		},
+		{
+			name:      "started after cutoff closes at start",
+			start:     local(29, 0),
+			now:       local(30, 0),
+			wantEnd:   local(29, 0),
+			wantClose: models.AutoCloseCutoff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attendance := models.Attendance{Registration: "123", StartTime: tt.start.UTC()}
+			if tt.finished {
+				end := tt.start.Add(time.Hour)
+				attendance.EndTime = &end
+			}
+
+			meeting := &models.Meeting{
+				Date:       date,
+				EndsAt:     tt.endsAt,
+				Attendance: []models.Attendance{attendance},
+			}
+
+			closes := closer.closeDue(meeting, tt.now)
+
+			if tt.wantClose == "" {
+				if len(closes) != 0 {
+					t.Errorf("closeDue() = %+v, want none", closes)
+				}
+				return
+			}
+
+			want := AttendanceClose{Position: 0, Registration: "123", EndTime: tt.wantEnd, Reason: tt.wantClose}
+			if len(closes) != 1 || closes[0].Position != want.Position ||
+				closes[0].Registration != want.Registration || !closes[0].EndTime.Equal(want.EndTime) ||
+				closes[0].Reason != want.Reason {
+				t.Errorf("closeDue() = %+v, want [%+v]", closes, want)
+			}
+		})
+	}
+}
+
+func TestCloseDueKeepsPositions(t *testing.T) {
+	closer := &AutoCloser{cutoff: 4 * time.Hour, location: testLocation}
+	end := local(20, 0)
+
+	meeting := &models.Meeting{
+		Date: local(0, 0).UTC(),
+		Attendance: []models.Attendance{
+			{Registration: "1", StartTime: local(19, 0)},
+			{Registration: "2", StartTime: local(19, 0), EndTime: &end},
+			{Registration: "3", StartTime: local(19, 30)},
+		},
+	}
+
+	closes := closer.closeDue(meeting, local(28, 0))
+
+	var got []int
+	for _, pending := range closes {
+		got = append(got, pending.Position)
+		if meeting.Attendance[pending.Position].Registration != pending.Registration {
+			t.Errorf("close at %d has registration %s, want %s",
+				pending.Position, pending.Registration, meeting.Attendance[pending.Position].Registration)
+		}
+	}
+
+	if !slices.Equal(got, []int{0, 2}) {
+		t.Errorf("closeDue() positions = %v, want [0 2]", got)
+	}
+}
+
+func TestApplyAttendanceCloses(t *testing.T) {
+	finished := local(21, 0)
+	closeAt := local(22, 0)
+
+	attendance := []models.Attendance{
+		{Registration: "1", StartTime: local(19, 0)},
+		{Registration: "2", StartTime: local(19, 0), EndTime: &finished},
+		{Registration: "3", StartTime: local(19, 0)},
+	}
+
+	tests := []struct {
+		name   string
+		close  AttendanceClose
+		closed bool
+	}{
+		{name: "open entry", close: AttendanceClose{Position: 0, Registration: "1"}, closed: true},
+		{name: "finished meanwhile", close: AttendanceClose{Position: 1, Registration: "2"}},
+		{name: "registration changed", close: AttendanceClose{Position: 2, Registration: "1"}},
+		{name: "position out of range", close: AttendanceClose{Position: 3, Registration: "1"}},
+		{name: "negative position", close: AttendanceClose{Position: -1, Registration: "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.close.EndTime = closeAt
+			tt.close.Reason = models.AutoCloseMeetingEnded
+
+			updated, count := applyAttendanceCloses(attendance, []AttendanceClose{tt.close})
+
+			if attendance[0].EndTime != nil || attendance[2].EndTime != nil {
+				t.Fatal("applyAttendanceCloses() changed its input")
+			}
+
+			if !tt.closed {
+				if count != 0 || !slices.Equal(updated, attendance) {
+					t.Errorf("applyAttendanceCloses() = %+v, %d, want unchanged", updated, count)
+				}
+				return
+			}
+
+			entry := updated[tt.close.Position]
+			if count != 1 || entry.EndTime == nil || !entry.EndTime.Equal(closeAt) ||
+				!entry.AutoClosed || entry.AutoCloseReason != models.AutoCloseMeetingEnded {
+				t.Errorf("applyAttendanceCloses() entry = %+v, count = %d, want closed at %s", entry, count, closeAt)
+			}
+		})
+	}
+}
+
+func TestCloseAttendanceKeepsLaterWrites(t *testing.T) {
+	repos := map[string]func(t *testing.T) MeetingRepository{
+		"memory": func(t *testing.T) MeetingRepository { return NewMemoryMeetingRepository() },
+		"sqlite": func(t *testing.T) MeetingRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			ctx := context.Background()
+			closer := &AutoCloser{cutoff: 4 * time.Hour, location: testLocation}
+
+			meeting, err := repo.Create(ctx, models.Meeting{Date: local(0, 0).UTC(), MeetingCode: "abc"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			attendance := []models.Attendance{
+				{Registration: "1", StartTime: local(19, 0)},
+				{Registration: "2", StartTime: local(19, 30)},
+			}
+			if err := repo.Update(ctx, meeting.ID, MeetingUpdate{Attendance: attendance}); err != nil {
+				t.Fatal(err)
+			}
+
+			stale, err := repo.GetByID(ctx, meeting.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			closes := closer.closeDue(stale, local(28, 0))
+
+			// The second member finishes by hand after the job read the meeting.
+			finished := local(27, 0)
+			attendance[1].EndTime = &finished
+			if err := repo.Update(ctx, meeting.ID, MeetingUpdate{Attendance: attendance}); err != nil {
+				t.Fatal(err)
+			}
+
+			closed, err := repo.CloseAttendance(ctx, meeting.ID, closes)
+			if err != nil || closed != 1 {
+				t.Fatalf("CloseAttendance() = %d, %v, want 1", closed, err)
+			}
+
+			stored, err := repo.GetByID(ctx, meeting.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if first := stored.Attendance[0]; first.EndTime == nil || !first.EndTime.Equal(local(28, 0)) || !first.AutoClosed {
+				t.Errorf("first attendance = %+v, want auto closed at the cutoff", first)
+			}
+
+			if second := stored.Attendance[1]; second.EndTime == nil || !second.EndTime.Equal(finished) || second.AutoClosed {
+				t.Errorf("second attendance = %+v, want its own end time kept", second)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// CheckIn registers the attendance of registration on the meeting identified
+// by meetingCode. Codes are only accepted on the day the meeting takes place,
+// or past midnight while a scheduled night session is still running, and
+// always together with the current rotating code.
+func (s *MeetingService) CheckIn(ctx context.Context, meetingCode, code, registration string) (*models.Meeting, error) {
+	meeting, err := s.repo.GetByCode(ctx, meetingCode)
+	if errors.Is(err, ErrMeetingNotFound) {
+		return nil, ErrInvalidMeetingCode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	today, err := util.LocalDate(now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize date: %w", err)
+	}
+
+	if !meeting.Date.Equal(*today) && !inProgress(meeting, now) {
+		return nil, ErrMeetingCodeExpired
+	}
+
+	if !validRotatingCode(meeting, code, now, s.codePeriod) {
+		return nil, ErrInvalidRotatingCode
+	}
+
+	if err := s.addAttendance(ctx, meeting, registration); err != nil {
+		return nil, err
+	}
+
+	return meeting, nil
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CheckInLink builds the URL members open to check in, carrying the meeting
+// code and the rotating code of the current window.
+func (s *MeetingService) CheckInLink(ctx context.Context, id string) (string, *RotatingCode, error) {
+	code, err := s.CurrentCode(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	link, err := url.Parse(s.checkInURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid check-in url: %w", err)
+	}
+
+	query := link.Query()
+	query.Set("meeting_code", code.MeetingCode)
+	query.Set("code", code.Code)
+	link.RawQuery = query.Encode()
+
+	return link.String(), code, nil
+}
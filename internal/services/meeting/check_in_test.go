@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const testCodePeriod = 30 * time.Second
+
+func newTestMeetingService(t *testing.T) *MeetingService {
+	t.Helper()
+
+	// util.NormalizeDate reads the timezone through config.Load.
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("NITELOG_STORAGE", "memory")
+
+	return NewMeetingService(NewMemoryMeetingRepository(), testCodePeriod, "https://example.com/check-in")
+}
+
+func TestCheckIn(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+
+	today, err := util.NormalizeDate(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todayMeeting, err := service.Create(ctx, models.Meeting{Date: *today})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pastMeeting, err := service.Create(ctx, models.Meeting{Date: today.AddDate(0, 0, -7)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current, err := service.CurrentCode(ctx, todayMeeting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		meetingCode  string
+		code         string
+		registration string
+		want         error
+	}{
+		{"valid codes", todayMeeting.MeetingCode, current.Code, "1", nil},
+		{"already checked in", todayMeeting.MeetingCode, current.Code, "1", ErrActiveAttendanceExists},
+		{"another user", todayMeeting.MeetingCode, current.Code, "2", nil},
+		{"missing rotating code", todayMeeting.MeetingCode, "", "3", ErrInvalidRotatingCode},
+		{"wrong rotating code", todayMeeting.MeetingCode, "000000x", "3", ErrInvalidRotatingCode},
+		{"unknown meeting code", "unknown", current.Code, "3", ErrInvalidMeetingCode},
+		{"meeting of another day", pastMeeting.MeetingCode, current.Code, "3", ErrMeetingCodeExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CheckIn(ctx, tt.meetingCode, tt.code, tt.registration)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("CheckIn() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	meeting, err := service.GetByID(ctx, todayMeeting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(meeting.Attendance) != 2 {
+		t.Errorf("got %d attendances, want 2", len(meeting.Attendance))
+	}
+}
+
+func TestCheckInLegacyMeeting(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+
+	today, err := util.NormalizeDate(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Meetings created before rotating codes have no secret.
+	meeting, err := service.repo.Create(ctx, models.Meeting{Date: *today, MeetingCode: "legacy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, code := range []string{"", "000000"} {
+		if _, err := service.CheckIn(ctx, "legacy", code, "1"); !errors.Is(err, ErrInvalidRotatingCode) {
+			t.Errorf("CheckIn(%q) without a secret = %v, want ErrInvalidRotatingCode", code, err)
+		}
+	}
+
+	current, err := service.CurrentCode(ctx, meeting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.CheckIn(ctx, "legacy", current.Code, "1"); err != nil {
+		t.Errorf("CheckIn() after the code was displayed = %v", err)
+	}
+}
+
+func TestValidRotatingCode(t *testing.T) {
+	meeting := &models.Meeting{CodeSecret: util.GenerateTOTPSecret()}
+	now := time.Unix(1_700_000_000, 0)
+
+	current, err := currentRotatingCode(meeting, now, testCodePeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if current.Period != 30 || !current.ExpiresAt.After(now) || current.ExpiresAt.Sub(now) > testCodePeriod {
+		t.Errorf("currentRotatingCode() = %+v", current)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"same window", now, true},
+		{"previous window", now.Add(testCodePeriod), true},
+		{"two windows later", now.Add(2 * testCodePeriod), false},
+		{"before it was shown", now.Add(-testCodePeriod), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validRotatingCode(meeting, current.Code, tt.at, testCodePeriod); got != tt.want {
+				t.Errorf("validRotatingCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,30 +2,39 @@ package services
 
 import (
 	"errors"
-
-	"nitelog/internal/services"
-
-	"cloud.google.com/go/firestore"
+	"time"
 )
 
 var (
 	ErrMeetingNotFound   = errors.New("meeting not found")
-	ErrDuplicateMeeting  = errors.New("meeting already exists for this date")
 	ErrMeetingCodeTaken  = errors.New("meeting code already taken")
-	ErrDateTaken         = errors.New("meeting date already taken")
 	ErrNoChangesDetected = errors.New("no changes detected on meeting update")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+
+	ErrMeetingRefRequired   = errors.New("meeting id, code or date is required")
+	ErrAmbiguousMeetingDate = errors.New("more than one meeting on this date, use the meeting id or code")
+	ErrTimeSlotOutsideDate  = errors.New("starts_at must be on the meeting date")
+	ErrInvalidTimeSlot      = errors.New("ends_at requires starts_at and must be after it")
+
+	ErrInvalidMeetingCode  = errors.New("invalid meeting code")
+	ErrMeetingCodeExpired  = errors.New("meeting code expired")
+	ErrInvalidRotatingCode = errors.New("invalid or expired rotating code")
 
-	ErrNoAttendanceToFinish   = errors.New("attendance not started or already finished for this date")
+	ErrNoAttendanceToFinish   = errors.New("attendance not started or already finished for this meeting")
 	ErrActiveAttendanceExists = errors.New("attendance already started")
 	ErrAttendanceNotFound     = errors.New("attendance not found")
 )
 
 type MeetingService struct {
-	collection *firestore.CollectionRef
+	repo       MeetingRepository
+	codePeriod time.Duration
+	checkInURL string
 }
 
-func NewMeetingService() *MeetingService {
+func NewMeetingService(repo MeetingRepository, codePeriod time.Duration, checkInURL string) *MeetingService {
 	return &MeetingService{
-		collection: services.GetCollection("meetings"),
+		repo:       repo,
+		codePeriod: codePeriod,
+		checkInURL: checkInURL,
 	}
 }
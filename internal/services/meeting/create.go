@@ -5,55 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"time"
 
 	"nitelog/internal/models"
 	"nitelog/internal/util"
-
-	"cloud.google.com/go/firestore"
 )
 
-func (s *MeetingService) Create(ctx context.Context, date time.Time) (*models.Meeting, error) {
-	meetingCode, err := s.generateUniqueMeetingCode(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate meeting code: %w", err)
-	}
-
-	exists, err := s.dateMeetingExists(ctx, date)
-	if err != nil {
-		return nil, fmt.Errorf("date check failed: %w", err)
-	}
-
-	if exists {
-		return nil, ErrDuplicateMeeting
-	}
-
-	meetingRef, _, err := s.collection.Add(ctx, map[string]any{
-		"date":        date,
-		"meetingCode": meetingCode,
-		"attendance":  []models.Attendance{},
-		"createdAt":   firestore.ServerTimestamp,
-		"deletedAt":   nil,
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create meeting: %w", err)
+// Create stores a new meeting with a fresh code. Any number of meetings may
+// share a date, title, location and time slot are taken from meeting.
+func (s *MeetingService) Create(ctx context.Context, meeting models.Meeting) (*models.Meeting, error) {
+	if err := validateTimeSlot(meeting.Date, meeting.StartsAt, meeting.EndsAt); err != nil {
+		return nil, err
 	}
 
-	doc, err := meetingRef.Get(ctx)
+	meetingCode, err := s.generateUniqueMeetingCode(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify creation: %w", err)
-	}
-
-	var meeting models.Meeting
-	if err := doc.DataTo(&meeting); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to generate meeting code: %w", err)
 	}
 
-	// TODO check if need this line
-	meeting.ID = doc.Ref.ID
+	meeting.MeetingCode = meetingCode
+	meeting.CodeSecret = util.GenerateTOTPSecret()
+	meeting.Attendance = []models.Attendance{}
 
-	return &meeting, nil
+	return s.repo.Create(ctx, meeting)
 }
 
 func (s *MeetingService) generateUniqueMeetingCode(ctx context.Context) (string, error) {
@@ -61,7 +34,7 @@ func (s *MeetingService) generateUniqueMeetingCode(ctx context.Context) (string,
 	for range maxAttempts {
 		code := util.GenerateMeetingCode()
 
-		exists, err := isMeetingCodeExists(ctx, s.collection, code)
+		exists, err := s.isMeetingCodeTaken(ctx, code, "")
 		if err != nil {
 			return "", fmt.Errorf("code check failed: %w", err)
 		}
@@ -74,26 +47,3 @@ func (s *MeetingService) generateUniqueMeetingCode(ctx context.Context) (string,
 	}
 	return "", errors.New("failed to generate unique code after 10 attempts")
 }
-
-func isMeetingCodeExists(ctx context.Context, coll *firestore.CollectionRef, code string) (bool, error) {
-	query := coll.Where("meetingCode", "==", code).Limit(1)
-	docs, err := query.Documents(ctx).GetAll()
-	if err != nil {
-		return false, fmt.Errorf("firestore query failed: %w", err)
-	}
-	return len(docs) > 0, nil
-}
-
-func (s *MeetingService) dateMeetingExists(ctx context.Context, date time.Time) (bool, error) {
-	query := s.collection.
-		Where("date", "==", date).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	docs, err := query.Documents(ctx).GetAll()
-	if err != nil {
-		return false, fmt.Errorf("firestore query failed: %w", err)
-	}
-
-	return len(docs) > 0, nil
-}
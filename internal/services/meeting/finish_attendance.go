@@ -2,31 +2,12 @@ package services
 
 import (
 	"context"
-	"fmt"
 	"time"
-
-	"nitelog/internal/models"
-
-	"cloud.google.com/go/firestore"
 )
 
-func (s *MeetingService) FinishAttendance(ctx context.Context, date time.Time, registration string) error {
-	query := s.collection.
-		Where("date", "==", date).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	docs, err := query.Documents(ctx).GetAll()
+func (s *MeetingService) FinishAttendance(ctx context.Context, ref MeetingRef, registration string) error {
+	meeting, err := s.Resolve(ctx, ref)
 	if err != nil {
-		return fmt.Errorf("failed to find meeting: %w", err)
-	}
-	if len(docs) == 0 {
-		return ErrMeetingNotFound
-	}
-
-	docRef := docs[0].Ref
-	var meeting models.Meeting
-	if err := docs[0].DataTo(&meeting); err != nil {
 		return err
 	}
 
@@ -44,16 +25,7 @@ func (s *MeetingService) FinishAttendance(ctx context.Context, date time.Time, r
 		return ErrNoAttendanceToFinish
 	}
 
-	_, err = docRef.Update(ctx, []firestore.Update{
-		{
-			Path:  "attendance",
-			Value: meeting.Attendance,
-		},
-		{
-			Path:  "updatedAt",
-			Value: firestore.ServerTimestamp,
-		},
+	return s.repo.Update(ctx, meeting.ID, MeetingUpdate{
+		Attendance: meeting.Attendance,
 	})
-
-	return err
 }
@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FirestoreMeetingRepository struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreMeetingRepository(client *firestore.Client) *FirestoreMeetingRepository {
+	return &FirestoreMeetingRepository{
+		client:     client,
+		collection: client.Collection("meetings"),
+	}
+}
+
+func (r *FirestoreMeetingRepository) Create(ctx context.Context, meeting models.Meeting) (*models.Meeting, error) {
+	meetingRef, _, err := r.collection.Add(ctx, map[string]any{
+		"date":        meeting.Date,
+		"title":       meeting.Title,
+		"location":    meeting.Location,
+		"startsAt":    meeting.StartsAt,
+		"endsAt":      meeting.EndsAt,
+		"scheduleId":  meeting.ScheduleID,
+		"meetingCode": meeting.MeetingCode,
+		"codeSecret":  meeting.CodeSecret,
+		"attendance":  []models.Attendance{},
+		"createdAt":   firestore.ServerTimestamp,
+		"deletedAt":   nil,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create meeting: %w", err)
+	}
+
+	doc, err := meetingRef.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify creation: %w", err)
+	}
+
+	return decodeMeeting(doc)
+}
+
+func (r *FirestoreMeetingRepository) GetByID(ctx context.Context, id string) (*models.Meeting, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrMeetingNotFound
+		}
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+
+	deletedAt, err := doc.DataAt("deletedAt")
+	if err != nil || deletedAt != nil {
+		return nil, ErrMeetingNotFound
+	}
+
+	return decodeMeeting(doc)
+}
+
+func (r *FirestoreMeetingRepository) ListByDate(ctx context.Context, date time.Time) ([]models.Meeting, error) {
+	docs, err := r.collection.
+		Where("date", "==", date).
+		Where("deletedAt", "==", nil).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	meetings := make([]models.Meeting, 0, len(docs))
+	for _, doc := range docs {
+		meeting, err := decodeMeeting(doc)
+		if err != nil {
+			return nil, err
+		}
+		meetings = append(meetings, *meeting)
+	}
+
+	return meetings, nil
+}
+
+func (r *FirestoreMeetingRepository) GetByCode(ctx context.Context, code string) (*models.Meeting, error) {
+	return r.findOne(ctx, "meetingCode", code)
+}
+
+func (r *FirestoreMeetingRepository) Update(ctx context.Context, id string, update MeetingUpdate) error {
+	var updates []firestore.Update
+
+	if update.Date != nil {
+		updates = append(updates, firestore.Update{Path: "date", Value: *update.Date})
+	}
+
+	if update.Title != nil {
+		updates = append(updates, firestore.Update{Path: "title", Value: *update.Title})
+	}
+
+	if update.Location != nil {
+		updates = append(updates, firestore.Update{Path: "location", Value: *update.Location})
+	}
+
+	if update.StartsAt != nil {
+		updates = append(updates, firestore.Update{Path: "startsAt", Value: *update.StartsAt})
+	}
+
+	if update.EndsAt != nil {
+		updates = append(updates, firestore.Update{Path: "endsAt", Value: *update.EndsAt})
+	}
+
+	if update.MeetingCode != nil {
+		updates = append(updates, firestore.Update{Path: "meetingCode", Value: *update.MeetingCode})
+	}
+
+	if update.CodeSecret != nil {
+		updates = append(updates, firestore.Update{Path: "codeSecret", Value: *update.CodeSecret})
+	}
+
+	if update.CodeRotatedAt != nil {
+		updates = append(updates, firestore.Update{Path: "codeRotatedAt", Value: *update.CodeRotatedAt})
+	}
+
+	if update.CodeRotatedBy != nil {
+		updates = append(updates, firestore.Update{Path: "codeRotatedBy", Value: *update.CodeRotatedBy})
+	}
+
+	if update.Attendance != nil {
+		updates = append(updates, firestore.Update{Path: "attendance", Value: update.Attendance})
+	}
+
+	updates = append(updates, firestore.Update{
+		Path:  "updatedAt",
+		Value: firestore.ServerTimestamp,
+	})
+
+	_, err := r.collection.Doc(id).Update(ctx, updates)
+	if status.Code(err) == codes.NotFound {
+		return ErrMeetingNotFound
+	}
+
+	return err
+}
+
+// CloseAttendance rewrites the attendance array in a transaction, so
+// check-ins and finishes racing with it are retried instead of lost.
+func (r *FirestoreMeetingRepository) CloseAttendance(ctx context.Context, id string, closes []AttendanceClose) (int, error) {
+	ref := r.collection.Doc(id)
+
+	var closed int
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrMeetingNotFound
+			}
+			return fmt.Errorf("failed to get meeting: %w", err)
+		}
+
+		meeting, err := decodeMeeting(doc)
+		if err != nil {
+			return err
+		}
+
+		var attendance []models.Attendance
+		attendance, closed = applyAttendanceCloses(meeting.Attendance, closes)
+		if closed == 0 {
+			return nil
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "attendance", Value: attendance},
+			{Path: "updatedAt", Value: firestore.ServerTimestamp},
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return closed, nil
+}
+
+func (r *FirestoreMeetingRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "deletedAt",
+			Value: firestore.ServerTimestamp,
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrMeetingNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreMeetingRepository) List(ctx context.Context, query MeetingListQuery) ([]models.Meeting, error) {
+	q := r.collection.Query
+	if query.ScheduleID != "" {
+		q = q.Where("scheduleId", "==", query.ScheduleID)
+	}
+
+	if !query.IncludeDeleted {
+		q = q.Where("deletedAt", "==", nil)
+	}
+
+	if query.From != nil {
+		q = q.Where("date", ">=", *query.From)
+	}
+
+	if query.To != nil {
+		q = q.Where("date", "<=", *query.To)
+	}
+
+	q = q.OrderBy("date", firestore.Asc).
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(query.Limit)
+
+	if query.After != nil {
+		q = q.StartAfter(query.After.Date, query.After.ID)
+	}
+
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	meetings := make([]models.Meeting, 0, len(docs))
+	for _, doc := range docs {
+		meeting, err := decodeMeeting(doc)
+		if err != nil {
+			return nil, err
+		}
+		meetings = append(meetings, *meeting)
+	}
+
+	return meetings, nil
+}
+
+func (r *FirestoreMeetingRepository) ListDeleted(ctx context.Context) ([]models.Meeting, error) {
+	docs, err := r.collection.
+		Where("deletedAt", "!=", nil).
+		OrderBy("deletedAt", firestore.Desc).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted meetings: %w", err)
+	}
+
+	meetings := make([]models.Meeting, 0, len(docs))
+	for _, doc := range docs {
+		meeting, err := decodeMeeting(doc)
+		if err != nil {
+			return nil, err
+		}
+		meetings = append(meetings, *meeting)
+	}
+
+	return meetings, nil
+}
+
+func (r *FirestoreMeetingRepository) GetDeletedByID(ctx context.Context, id string) (*models.Meeting, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrMeetingNotFound
+		}
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+
+	meeting, err := decodeMeeting(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if meeting.DeletedAt == nil {
+		return nil, ErrMeetingNotFound
+	}
+
+	return meeting, nil
+}
+
+func (r *FirestoreMeetingRepository) Restore(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "deletedAt",
+			Value: nil,
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrMeetingNotFound
+	}
+
+	return err
+}
+
+// ListByAttendee scans the whole collection, attendance entries live in an
+// array of maps that Firestore cannot filter on.
+func (r *FirestoreMeetingRepository) ListByAttendee(ctx context.Context, registration string) ([]models.Meeting, error) {
+	iter := r.collection.Documents(ctx)
+	defer iter.Stop()
+
+	meetings := make([]models.Meeting, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate meetings: %w", err)
+		}
+
+		meeting, err := decodeMeeting(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(meeting.Attendance, func(a models.Attendance) bool {
+			return a.Registration == registration
+		}) {
+			meetings = append(meetings, *meeting)
+		}
+	}
+
+	return meetings, nil
+}
+
+func (r *FirestoreMeetingRepository) HardDelete(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Delete(ctx)
+	if status.Code(err) == codes.NotFound {
+		return ErrMeetingNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreMeetingRepository) findOne(ctx context.Context, field string, value any) (*models.Meeting, error) {
+	query := r.collection.
+		Where(field, "==", value).
+		Where("deletedAt", "==", nil).
+		Limit(1)
+
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	if len(docs) == 0 {
+		return nil, ErrMeetingNotFound
+	}
+
+	return decodeMeeting(docs[0])
+}
+
+func decodeMeeting(doc *firestore.DocumentSnapshot) (*models.Meeting, error) {
+	var meeting models.Meeting
+	if err := doc.DataTo(&meeting); err != nil {
+		return nil, fmt.Errorf("failed to decode meeting: %w", err)
+	}
+
+	meeting.ID = doc.Ref.ID
+
+	return &meeting, nil
+}
@@ -2,34 +2,54 @@ package services
 
 import (
 	"context"
-	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"nitelog/internal/models"
 )
 
-func (s *MeetingService) GetByDate(ctx context.Context, date time.Time) (*models.Meeting, error) {
-	query := s.collection.
-		Where("date", "==", date).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	docs, err := query.Documents(ctx).GetAll()
+// ListByDate returns the meetings of a day ordered by start time, meetings
+// without a scheduled start come last.
+func (s *MeetingService) ListByDate(ctx context.Context, date time.Time) ([]models.Meeting, error) {
+	meetings, err := s.repo.ListByDate(ctx, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query meetings: %w", err)
+		return nil, err
 	}
 
-	if len(docs) == 0 {
-		return nil, ErrMeetingNotFound
-	}
+	slices.SortFunc(meetings, func(a, b models.Meeting) int {
+		switch {
+		case a.StartsAt == nil && b.StartsAt == nil:
+			return strings.Compare(a.ID, b.ID)
+		case a.StartsAt == nil:
+			return 1
+		case b.StartsAt == nil:
+			return -1
+		}
+
+		if c := a.StartsAt.Compare(*b.StartsAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return meetings, nil
+}
 
-	doc := docs[0]
-	var meeting models.Meeting
-	if err := doc.DataTo(&meeting); err != nil {
-		return nil, fmt.Errorf("failed to decode meeting: %w", err)
+// GetByDate keeps the by-date lookups working on days with a single
+// meeting, days with more return ErrAmbiguousMeetingDate.
+func (s *MeetingService) GetByDate(ctx context.Context, date time.Time) (*models.Meeting, error) {
+	meetings, err := s.repo.ListByDate(ctx, date)
+	if err != nil {
+		return nil, err
 	}
 
-	meeting.ID = doc.Ref.ID
-
-	return &meeting, nil
+	switch len(meetings) {
+	case 0:
+		return nil, ErrMeetingNotFound
+	case 1:
+		return &meetings[0], nil
+	default:
+		return nil, ErrAmbiguousMeetingDate
+	}
 }
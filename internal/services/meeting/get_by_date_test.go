@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+func TestGetByDate(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	if _, err := service.GetByDate(ctx, date); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("GetByDate() of an empty day = %v, want ErrMeetingNotFound", err)
+	}
+
+	first, err := service.Create(ctx, models.Meeting{Date: date, Title: "Noite"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meeting, err := service.GetByDate(ctx, date)
+	if err != nil || meeting.ID != first.ID {
+		t.Errorf("GetByDate() = %v, %v, want the only meeting of the day", meeting, err)
+	}
+
+	startsAt := date.Add(16 * time.Hour)
+	second, err := service.Create(ctx, models.Meeting{Date: date, Title: "Tarde", StartsAt: &startsAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.GetByDate(ctx, date); !errors.Is(err, ErrAmbiguousMeetingDate) {
+		t.Errorf("GetByDate() of a day with two meetings = %v, want ErrAmbiguousMeetingDate", err)
+	}
+
+	meetings, err := service.ListByDate(ctx, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Meetings with a start time come first.
+	if len(meetings) != 2 || meetings[0].ID != second.ID || meetings[1].ID != first.ID {
+		t.Errorf("ListByDate() = %+v, want the scheduled meeting first", meetings)
+	}
+
+	if _, err := service.Resolve(ctx, MeetingRef{ID: first.ID}); err != nil {
+		t.Errorf("Resolve() by id = %v", err)
+	}
+
+	if _, err := service.Resolve(ctx, MeetingRef{}); !errors.Is(err, ErrMeetingRefRequired) {
+		t.Errorf("Resolve() without a reference = %v, want ErrMeetingRefRequired", err)
+	}
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+const (
+	DefaultMeetingPageSize = 50
+	MaxMeetingPageSize     = 200
+)
+
+type MeetingListOptions struct {
+	ScheduleID     string
+	From           *time.Time
+	To             *time.Time
+	IncludeDeleted bool
+	Limit          int
+	Cursor         string
+}
+
+// @model MeetingPage
+type MeetingPage struct {
+	Meetings   []models.Meeting `json:"meetings"`
+	NextCursor string           `json:"next_cursor,omitempty" example:"eyJkIjoiMjAyNC0xMC0yNlQwMzowMDowMFoiLCJpIjoiYTFiMmMzIn0"`
+}
+
+// @model MeetingSummaryPage
+type MeetingSummaryPage struct {
+	Meetings   []models.MeetingSummary `json:"meetings"`
+	NextCursor string                  `json:"next_cursor,omitempty" example:"eyJkIjoiMjAyNC0xMC0yNlQwMzowMDowMFoiLCJpIjoiYTFiMmMzIn0"`
+}
+
+// List returns a page of meetings ordered by date. The cursor is opaque to
+// clients, it is the one returned as NextCursor by the previous page.
+func (s *MeetingService) List(ctx context.Context, opts MeetingListOptions) (*MeetingPage, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultMeetingPageSize
+	}
+	limit = min(limit, MaxMeetingPageSize)
+
+	query := MeetingListQuery{
+		ScheduleID:     opts.ScheduleID,
+		From:           opts.From,
+		To:             opts.To,
+		IncludeDeleted: opts.IncludeDeleted,
+		Limit:          limit + 1,
+	}
+
+	if opts.Cursor != "" {
+		cursor, err := decodeMeetingCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.After = cursor
+	}
+
+	meetings, err := s.repo.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &MeetingPage{Meetings: meetings}
+	if len(meetings) > limit {
+		page.Meetings = meetings[:limit]
+
+		last := page.Meetings[limit-1]
+		page.NextCursor = encodeMeetingCursor(MeetingCursor{Date: last.Date, ID: last.ID})
+	}
+
+	return page, nil
+}
+
+// Summary drops the attendance lists of the page.
+func (p *MeetingPage) Summary() *MeetingSummaryPage {
+	summaries := make([]models.MeetingSummary, 0, len(p.Meetings))
+	for _, meeting := range p.Meetings {
+		summaries = append(summaries, meeting.Summary())
+	}
+
+	return &MeetingSummaryPage{
+		Meetings:   summaries,
+		NextCursor: p.NextCursor,
+	}
+}
+
+func encodeMeetingCursor(cursor MeetingCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeMeetingCursor(value string) (*MeetingCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor MeetingCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+func TestList(t *testing.T) {
+	repos := map[string]func(t *testing.T) MeetingRepository{
+		"memory": func(t *testing.T) MeetingRepository { return NewMemoryMeetingRepository() },
+		"sqlite": func(t *testing.T) MeetingRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			testList(t, NewMeetingService(newRepo(t), testCodePeriod, ""))
+		})
+	}
+}
+
+func testList(t *testing.T, service *MeetingService) {
+	ctx := context.Background()
+	first := time.Date(2025, time.September, 1, 3, 0, 0, 0, time.UTC)
+
+	var ids []string
+	for i := range 5 {
+		meeting, err := service.Create(ctx, models.Meeting{Date: first.AddDate(0, 0, i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, meeting.ID)
+	}
+
+	if err := service.SoftDelete(ctx, ids[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(opts MeetingListOptions) []string {
+		t.Helper()
+
+		var got []string
+		for {
+			page, err := service.List(ctx, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, meeting := range page.Meetings {
+				got = append(got, meeting.ID)
+			}
+			if page.NextCursor == "" {
+				return got
+			}
+			opts.Cursor = page.NextCursor
+		}
+	}
+
+	from, to := first.AddDate(0, 0, 1), first.AddDate(0, 0, 3)
+
+	tests := []struct {
+		name string
+		opts MeetingListOptions
+		want []string
+	}{
+		{"all pages", MeetingListOptions{Limit: 2}, []string{ids[0], ids[1], ids[3], ids[4]}},
+		{"include deleted", MeetingListOptions{Limit: 2, IncludeDeleted: true}, ids},
+		{"date range", MeetingListOptions{Limit: 1, From: &from, To: &to}, []string{ids[1], ids[3]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := list(tt.opts); !slices.Equal(got, tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := service.List(ctx, MeetingListOptions{Cursor: "not a cursor"}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("List() with a bad cursor = %v, want ErrInvalidCursor", err)
+	}
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// MemoryMeetingRepository keeps meetings in process memory. It is meant for
+// local development and tests, all data is lost when the server stops.
+type MemoryMeetingRepository struct {
+	mu       sync.RWMutex
+	meetings map[string]models.Meeting
+}
+
+func NewMemoryMeetingRepository() *MemoryMeetingRepository {
+	return &MemoryMeetingRepository{
+		meetings: make(map[string]models.Meeting),
+	}
+}
+
+func (r *MemoryMeetingRepository) Create(ctx context.Context, meeting models.Meeting) (*models.Meeting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, found := r.find(func(m models.Meeting) bool { return m.MeetingCode == meeting.MeetingCode }); found {
+		return nil, ErrMeetingCodeTaken
+	}
+
+	meeting.ID = util.GenerateID()
+	meeting.Attendance = []models.Attendance{}
+	meeting.CreatedAt = time.Now()
+	meeting.DeletedAt = nil
+	r.meetings[meeting.ID] = meeting
+
+	return copyMeeting(meeting), nil
+}
+
+func (r *MemoryMeetingRepository) GetByID(ctx context.Context, id string) (*models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok || meeting.DeletedAt != nil {
+		return nil, ErrMeetingNotFound
+	}
+
+	return copyMeeting(meeting), nil
+}
+
+func (r *MemoryMeetingRepository) ListByDate(ctx context.Context, date time.Time) ([]models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meetings := make([]models.Meeting, 0)
+	for _, meeting := range r.meetings {
+		if meeting.DeletedAt == nil && meeting.Date.Equal(date) {
+			meetings = append(meetings, *copyMeeting(meeting))
+		}
+	}
+
+	return meetings, nil
+}
+
+func (r *MemoryMeetingRepository) GetByCode(ctx context.Context, code string) (*models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meeting, found := r.find(func(m models.Meeting) bool { return m.MeetingCode == code })
+	if !found {
+		return nil, ErrMeetingNotFound
+	}
+
+	return copyMeeting(meeting), nil
+}
+
+func (r *MemoryMeetingRepository) Update(ctx context.Context, id string, update MeetingUpdate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok {
+		return ErrMeetingNotFound
+	}
+
+	if update.Date != nil {
+		meeting.Date = *update.Date
+	}
+
+	if update.Title != nil {
+		meeting.Title = *update.Title
+	}
+
+	if update.Location != nil {
+		meeting.Location = *update.Location
+	}
+
+	if update.StartsAt != nil {
+		startsAt := *update.StartsAt
+		meeting.StartsAt = &startsAt
+	}
+
+	if update.EndsAt != nil {
+		endsAt := *update.EndsAt
+		meeting.EndsAt = &endsAt
+	}
+
+	if update.MeetingCode != nil {
+		other, found := r.find(func(m models.Meeting) bool { return m.MeetingCode == *update.MeetingCode })
+		if found && other.ID != id {
+			return ErrMeetingCodeTaken
+		}
+		meeting.MeetingCode = *update.MeetingCode
+	}
+
+	if update.CodeSecret != nil {
+		meeting.CodeSecret = *update.CodeSecret
+	}
+
+	if update.CodeRotatedAt != nil {
+		rotatedAt := *update.CodeRotatedAt
+		meeting.CodeRotatedAt = &rotatedAt
+	}
+
+	if update.CodeRotatedBy != nil {
+		meeting.CodeRotatedBy = *update.CodeRotatedBy
+	}
+
+	if update.Attendance != nil {
+		meeting.Attendance = slices.Clone(update.Attendance)
+	}
+
+	r.meetings[id] = meeting
+
+	return nil
+}
+
+func (r *MemoryMeetingRepository) CloseAttendance(ctx context.Context, id string, closes []AttendanceClose) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok {
+		return 0, ErrMeetingNotFound
+	}
+
+	attendance, closed := applyAttendanceCloses(meeting.Attendance, closes)
+	meeting.Attendance = attendance
+	r.meetings[id] = meeting
+
+	return closed, nil
+}
+
+func (r *MemoryMeetingRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok {
+		return ErrMeetingNotFound
+	}
+
+	now := time.Now()
+	meeting.DeletedAt = &now
+	r.meetings[id] = meeting
+
+	return nil
+}
+
+func (r *MemoryMeetingRepository) List(ctx context.Context, query MeetingListQuery) ([]models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meetings := make([]models.Meeting, 0)
+	for _, meeting := range r.meetings {
+		if query.ScheduleID != "" && meeting.ScheduleID != query.ScheduleID {
+			continue
+		}
+
+		if meeting.DeletedAt != nil && !query.IncludeDeleted {
+			continue
+		}
+
+		if query.From != nil && meeting.Date.Before(*query.From) {
+			continue
+		}
+
+		if query.To != nil && meeting.Date.After(*query.To) {
+			continue
+		}
+
+		if query.After != nil && compareMeetingPosition(meeting, query.After.Date, query.After.ID) <= 0 {
+			continue
+		}
+
+		meetings = append(meetings, meeting)
+	}
+
+	slices.SortFunc(meetings, func(a, b models.Meeting) int {
+		return compareMeetingPosition(a, b.Date, b.ID)
+	})
+
+	if len(meetings) > query.Limit {
+		meetings = meetings[:query.Limit]
+	}
+
+	for i := range meetings {
+		meetings[i] = *copyMeeting(meetings[i])
+	}
+
+	return meetings, nil
+}
+
+func (r *MemoryMeetingRepository) ListDeleted(ctx context.Context) ([]models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meetings := make([]models.Meeting, 0)
+	for _, meeting := range r.meetings {
+		if meeting.DeletedAt != nil {
+			meetings = append(meetings, *copyMeeting(meeting))
+		}
+	}
+
+	slices.SortFunc(meetings, func(a, b models.Meeting) int {
+		return b.DeletedAt.Compare(*a.DeletedAt)
+	})
+
+	return meetings, nil
+}
+
+func (r *MemoryMeetingRepository) GetDeletedByID(ctx context.Context, id string) (*models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok || meeting.DeletedAt == nil {
+		return nil, ErrMeetingNotFound
+	}
+
+	return copyMeeting(meeting), nil
+}
+
+func (r *MemoryMeetingRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	meeting, ok := r.meetings[id]
+	if !ok || meeting.DeletedAt == nil {
+		return ErrMeetingNotFound
+	}
+
+	if _, found := r.find(func(m models.Meeting) bool { return m.MeetingCode == meeting.MeetingCode }); found {
+		return ErrMeetingCodeTaken
+	}
+
+	meeting.DeletedAt = nil
+	r.meetings[id] = meeting
+
+	return nil
+}
+
+func (r *MemoryMeetingRepository) ListByAttendee(ctx context.Context, registration string) ([]models.Meeting, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meetings := make([]models.Meeting, 0)
+	for _, meeting := range r.meetings {
+		if slices.ContainsFunc(meeting.Attendance, func(a models.Attendance) bool {
+			return a.Registration == registration
+		}) {
+			meetings = append(meetings, *copyMeeting(meeting))
+		}
+	}
+
+	return meetings, nil
+}
+
+func (r *MemoryMeetingRepository) HardDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.meetings[id]; !ok {
+		return ErrMeetingNotFound
+	}
+
+	delete(r.meetings, id)
+
+	return nil
+}
+
+// find returns the first non deleted meeting matching the predicate, callers
+// must hold the lock.
+func (r *MemoryMeetingRepository) find(match func(models.Meeting) bool) (models.Meeting, bool) {
+	for _, meeting := range r.meetings {
+		if meeting.DeletedAt == nil && match(meeting) {
+			return meeting, true
+		}
+	}
+	return models.Meeting{}, false
+}
+
+// compareMeetingPosition orders meetings the same way List pages through
+// them, by date and then by id.
+func compareMeetingPosition(meeting models.Meeting, date time.Time, id string) int {
+	if c := meeting.Date.Compare(date); c != 0 {
+		return c
+	}
+	return strings.Compare(meeting.ID, id)
+}
+
+func copyMeeting(meeting models.Meeting) *models.Meeting {
+	meeting.Attendance = slices.Clone(meeting.Attendance)
+	return &meeting
+}
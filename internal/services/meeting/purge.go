@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PurgeDeleted permanently removes the meetings soft deleted before the
+// cutoff and returns how many were (or, on a dry run, would be) removed.
+func (s *MeetingService) PurgeDeleted(ctx context.Context, before time.Time, dryRun bool) (int, error) {
+	meetings, err := s.repo.ListDeleted(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, meeting := range meetings {
+		if !meeting.DeletedAt.Before(before) {
+			continue
+		}
+
+		if !dryRun {
+			if err := s.repo.HardDelete(ctx, meeting.ID); err != nil {
+				return purged, fmt.Errorf("failed to purge meeting %s: %w", meeting.ID, err)
+			}
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// AnonymizeAttendance replaces registration by pseudonym on the attendance
+// entries started until the given time, entries created afterwards belong
+// to whoever reused the registration and are kept.
+func (s *MeetingService) AnonymizeAttendance(ctx context.Context, registration, pseudonym string, until time.Time, dryRun bool) (int, error) {
+	meetings, err := s.repo.ListByAttendee(ctx, registration)
+	if err != nil {
+		return 0, err
+	}
+
+	anonymized := 0
+	for _, meeting := range meetings {
+		changed := false
+		for i := range meeting.Attendance {
+			attendance := &meeting.Attendance[i]
+			if attendance.Registration != registration || attendance.StartTime.After(until) {
+				continue
+			}
+
+			attendance.Registration = pseudonym
+			changed = true
+			anonymized++
+		}
+
+		if !changed || dryRun {
+			continue
+		}
+
+		err := s.repo.Update(ctx, meeting.ID, MeetingUpdate{Attendance: meeting.Attendance})
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to anonymize meeting %s: %w", meeting.ID, err)
+		}
+	}
+
+	return anonymized, nil
+}
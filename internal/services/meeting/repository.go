@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MeetingRepository is the storage backend used by MeetingService.
+// Implementations must ignore soft deleted meetings on every lookup and
+// return ErrMeetingNotFound when nothing matches. Backends able to enforce
+// uniqueness atomically report meeting code conflicts with
+// ErrMeetingCodeTaken themselves.
+type MeetingRepository interface {
+	Create(ctx context.Context, meeting models.Meeting) (*models.Meeting, error)
+	GetByID(ctx context.Context, id string) (*models.Meeting, error)
+	ListByDate(ctx context.Context, date time.Time) ([]models.Meeting, error)
+	GetByCode(ctx context.Context, code string) (*models.Meeting, error)
+
+	Update(ctx context.Context, id string, update MeetingUpdate) error
+
+	// CloseAttendance applies closes to the attendance entries that are
+	// still open and still belong to the registration of the close, entries
+	// finished or replaced since they were read are left alone. It returns
+	// how many entries were closed.
+	CloseAttendance(ctx context.Context, id string, closes []AttendanceClose) (int, error)
+	SoftDelete(ctx context.Context, id string) error
+
+	// List returns up to query.Limit meetings ordered by date and then id,
+	// starting right after query.After when it is set.
+	List(ctx context.Context, query MeetingListQuery) ([]models.Meeting, error)
+
+	// ListDeleted, GetDeletedByID and Restore only see soft deleted meetings.
+	ListDeleted(ctx context.Context) ([]models.Meeting, error)
+	GetDeletedByID(ctx context.Context, id string) (*models.Meeting, error)
+	Restore(ctx context.Context, id string) error
+
+	// ListByAttendee returns every meeting, deleted or not, with an
+	// attendance entry for registration.
+	ListByAttendee(ctx context.Context, registration string) ([]models.Meeting, error)
+	HardDelete(ctx context.Context, id string) error
+}
+
+// MeetingUpdate holds the fields to be changed on a meeting, nil fields are
+// left untouched.
+type MeetingUpdate struct {
+	Date        *time.Time
+	Title       *string
+	Location    *string
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	MeetingCode *string
+	CodeSecret  *string
+	Attendance  []models.Attendance
+
+	CodeRotatedAt *time.Time
+	CodeRotatedBy *string
+}
+
+// AttendanceClose finishes the attendance entry at Position of a meeting on
+// behalf of the system.
+type AttendanceClose struct {
+	Position     int
+	Registration string
+	EndTime      time.Time
+	Reason       string
+}
+
+// MeetingListQuery filters a List call. From and To are inclusive,
+// ScheduleID restricts the result to the meetings created by a schedule and
+// IncludeDeleted also returns soft deleted meetings.
+type MeetingListQuery struct {
+	ScheduleID     string
+	From           *time.Time
+	To             *time.Time
+	IncludeDeleted bool
+	After          *MeetingCursor
+	Limit          int
+}
+
+// MeetingCursor is the position of the last meeting of a page.
+type MeetingCursor struct {
+	Date time.Time `json:"d"`
+	ID   string    `json:"i"`
+}
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MeetingRef identifies the meeting an attendance operation applies to. The
+// first field set is used, Date only works on days with a single meeting.
+type MeetingRef struct {
+	ID   string
+	Code string
+	Date *time.Time
+}
+
+func (s *MeetingService) Resolve(ctx context.Context, ref MeetingRef) (*models.Meeting, error) {
+	switch {
+	case ref.ID != "":
+		return s.repo.GetByID(ctx, ref.ID)
+	case ref.Code != "":
+		return s.repo.GetByCode(ctx, ref.Code)
+	case ref.Date != nil:
+		return s.GetByDate(ctx, *ref.Date)
+	default:
+		return nil, ErrMeetingRefRequired
+	}
+}
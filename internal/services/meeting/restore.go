@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"nitelog/internal/models"
+)
+
+func (s *MeetingService) ListDeleted(ctx context.Context) ([]models.Meeting, error) {
+	return s.repo.ListDeleted(ctx)
+}
+
+// Restore undoes a soft delete, failing with ErrMeetingCodeTaken when the
+// code of the meeting has been reused since it was deleted.
+func (s *MeetingService) Restore(ctx context.Context, id string) (*models.Meeting, error) {
+	meeting, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	codeTaken, err := s.isMeetingCodeTaken(ctx, meeting.MeetingCode, id)
+	if err != nil {
+		return nil, fmt.Errorf("meeting code check failed: %w", err)
+	}
+	if codeTaken {
+		return nil, ErrMeetingCodeTaken
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
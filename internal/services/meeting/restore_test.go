@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+func TestRestore(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	deleted, err := service.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.SoftDelete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Restore(ctx, deleted.ID); err != nil {
+		t.Fatalf("Restore() = %v", err)
+	}
+
+	if _, err := service.GetByID(ctx, deleted.ID); err != nil {
+		t.Errorf("GetByID() after restore = %v", err)
+	}
+
+	if _, err := service.Restore(ctx, deleted.ID); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("Restore() of a meeting that is not deleted = %v, want ErrMeetingNotFound", err)
+	}
+}
+
+func TestRestoreCodeTaken(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	deleted, err := service.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.SoftDelete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := service.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := deleted.MeetingCode
+	if _, err := service.Update(ctx, other.ID, models.Meeting{MeetingCode: code}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Restore(ctx, deleted.ID); !errors.Is(err, ErrMeetingCodeTaken) {
+		t.Errorf("Restore() = %v, want ErrMeetingCodeTaken", err)
+	}
+}
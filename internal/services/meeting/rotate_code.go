@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// RotateCode replaces the meeting code and its rotating code secret, so
+// both the old static code and any code derived from it stop working at
+// once. rotatedBy is the ID of the user requesting the change.
+func (s *MeetingService) RotateCode(ctx context.Context, id string, rotatedBy string) (*models.Meeting, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	meetingCode, err := s.generateUniqueMeetingCode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate meeting code: %w", err)
+	}
+
+	secret := util.GenerateTOTPSecret()
+	now := time.Now()
+
+	err = s.repo.Update(ctx, id, MeetingUpdate{
+		MeetingCode:   &meetingCode,
+		CodeSecret:    &secret,
+		CodeRotatedAt: &now,
+		CodeRotatedBy: &rotatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+func TestRotateCodeInvalidatesOldCodes(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+
+	today, err := util.NormalizeDate(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meeting, err := service.Create(ctx, models.Meeting{Date: *today})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	old, err := service.CurrentCode(ctx, meeting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := service.RotateCode(ctx, meeting.ID, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rotated.MeetingCode == old.MeetingCode || rotated.CodeRotatedBy != "admin" || rotated.CodeRotatedAt == nil {
+		t.Fatalf("RotateCode() = %+v", rotated)
+	}
+
+	if _, err := service.CheckIn(ctx, old.MeetingCode, old.Code, "1"); !errors.Is(err, ErrInvalidMeetingCode) {
+		t.Errorf("CheckIn() with the old meeting code = %v, want ErrInvalidMeetingCode", err)
+	}
+
+	current, err := service.CurrentCode(ctx, meeting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if current.Code == old.Code {
+		// Both secrets deriving the same six digits is possible but rare,
+		// the old code is then still a valid one.
+		t.Skip("old and new secret derived the same code")
+	}
+
+	if _, err := service.CheckIn(ctx, current.MeetingCode, old.Code, "1"); !errors.Is(err, ErrInvalidRotatingCode) {
+		t.Errorf("CheckIn() with the old rotating code = %v, want ErrInvalidRotatingCode", err)
+	}
+
+	if _, err := service.CheckIn(ctx, current.MeetingCode, current.Code, "1"); err != nil {
+		t.Errorf("CheckIn() with the new codes = %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const (
+	rotatingCodeDigits = 6
+	// rotatingCodeSkew is how many past windows are still accepted, so a code
+	// read right before it rotates can still be used.
+	rotatingCodeSkew = 1
+)
+
+// @model RotatingCode
+type RotatingCode struct {
+	MeetingCode string     `json:"meeting_code" example:"qE522Af8"`
+	Code        string     `json:"code,omitempty" example:"492039"`
+	Rotating    bool       `json:"rotating" example:"true"`
+	Period      int        `json:"period_seconds,omitempty" example:"30"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2025-05-14T20:19:30Z"`
+}
+
+// CurrentCode returns the code to be displayed on the organizer screen.
+// Meetings created before rotating codes get their secret the first time
+// their code is displayed, until then nobody can check in to them.
+func (s *MeetingService) CurrentCode(ctx context.Context, id string) (*RotatingCode, error) {
+	meeting, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if meeting.CodeSecret == "" {
+		secret := util.GenerateTOTPSecret()
+		if err := s.repo.Update(ctx, id, MeetingUpdate{CodeSecret: &secret}); err != nil {
+			return nil, fmt.Errorf("failed to set meeting code secret: %w", err)
+		}
+		meeting.CodeSecret = secret
+	}
+
+	return currentRotatingCode(meeting, time.Now(), s.codePeriod)
+}
+
+func currentRotatingCode(meeting *models.Meeting, now time.Time, period time.Duration) (*RotatingCode, error) {
+	result := &RotatingCode{MeetingCode: meeting.MeetingCode}
+
+	counter := util.TOTPCounter(now, period)
+
+	code, err := util.TOTP(meeting.CodeSecret, counter, rotatingCodeDigits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive meeting code: %w", err)
+	}
+
+	expiresAt := time.Unix(int64(counter+1)*int64(period/time.Second), 0).UTC()
+
+	result.Code = code
+	result.Rotating = true
+	result.Period = int(period / time.Second)
+	result.ExpiresAt = &expiresAt
+
+	return result, nil
+}
+
+// validRotatingCode accepts the code of the current and previous window.
+// Meetings without a secret accept no code, their meeting code alone is
+// known to every member.
+func validRotatingCode(meeting *models.Meeting, code string, now time.Time, period time.Duration) bool {
+	if meeting.CodeSecret == "" {
+		return false
+	}
+
+	return util.ValidateTOTP(
+		meeting.CodeSecret,
+		code,
+		now,
+		period,
+		rotatingCodeDigits,
+		rotatingCodeSkew,
+	)
+}
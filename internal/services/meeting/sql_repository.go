@@ -0,0 +1,481 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const meetingColumns = "id, date, title, location, starts_at, ends_at, schedule_id, meeting_code, code_secret, " +
+	"code_rotated_at, code_rotated_by, created_at, deleted_at"
+
+type SQLMeetingRepository struct {
+	db *database.DB
+}
+
+func NewSQLMeetingRepository(db *database.DB) *SQLMeetingRepository {
+	return &SQLMeetingRepository{db: db}
+}
+
+func (r *SQLMeetingRepository) Create(ctx context.Context, meeting models.Meeting) (*models.Meeting, error) {
+	meeting.ID = util.GenerateID()
+	meeting.Date = meeting.Date.UTC()
+	meeting.Attendance = []models.Attendance{}
+	meeting.CreatedAt = time.Now().UTC()
+	meeting.DeletedAt = nil
+
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO meetings ("+meetingColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, '', ?, NULL)"),
+		meeting.ID, meeting.Date, meeting.Title, meeting.Location, nullTime(meeting.StartsAt), nullTime(meeting.EndsAt),
+		meeting.ScheduleID, meeting.MeetingCode, meeting.CodeSecret, meeting.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, meetingConstraintError(err, "failed to create meeting")
+	}
+
+	return &meeting, nil
+}
+
+func (r *SQLMeetingRepository) GetByID(ctx context.Context, id string) (*models.Meeting, error) {
+	return r.findOne(ctx, "id = ? AND deleted_at IS NULL", id)
+}
+
+func (r *SQLMeetingRepository) ListByDate(ctx context.Context, date time.Time) ([]models.Meeting, error) {
+	rows, err := r.db.QueryContext(ctx,
+		r.db.Rebind("SELECT "+meetingColumns+" FROM meetings WHERE date = ? AND deleted_at IS NULL"),
+		date.UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	return r.scanMeetings(ctx, rows)
+}
+
+func (r *SQLMeetingRepository) GetByCode(ctx context.Context, code string) (*models.Meeting, error) {
+	return r.findOne(ctx, "meeting_code = ? AND deleted_at IS NULL", code)
+}
+
+func (r *SQLMeetingRepository) Update(ctx context.Context, id string, update MeetingUpdate) error {
+	sets := []string{"updated_at = ?"}
+	args := []any{time.Now().UTC()}
+
+	if update.Date != nil {
+		sets = append(sets, "date = ?")
+		args = append(args, update.Date.UTC())
+	}
+
+	if update.Title != nil {
+		sets = append(sets, "title = ?")
+		args = append(args, *update.Title)
+	}
+
+	if update.Location != nil {
+		sets = append(sets, "location = ?")
+		args = append(args, *update.Location)
+	}
+
+	if update.StartsAt != nil {
+		sets = append(sets, "starts_at = ?")
+		args = append(args, update.StartsAt.UTC())
+	}
+
+	if update.EndsAt != nil {
+		sets = append(sets, "ends_at = ?")
+		args = append(args, update.EndsAt.UTC())
+	}
+
+	if update.MeetingCode != nil {
+		sets = append(sets, "meeting_code = ?")
+		args = append(args, *update.MeetingCode)
+	}
+
+	if update.CodeSecret != nil {
+		sets = append(sets, "code_secret = ?")
+		args = append(args, *update.CodeSecret)
+	}
+
+	if update.CodeRotatedAt != nil {
+		sets = append(sets, "code_rotated_at = ?")
+		args = append(args, update.CodeRotatedAt.UTC())
+	}
+
+	if update.CodeRotatedBy != nil {
+		sets = append(sets, "code_rotated_by = ?")
+		args = append(args, *update.CodeRotatedBy)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		r.db.Rebind("UPDATE meetings SET "+strings.Join(sets, ", ")+" WHERE id = ?"),
+		append(args, id)...,
+	)
+	if err != nil {
+		return meetingConstraintError(err, "failed to update meeting")
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrMeetingNotFound
+	}
+
+	if update.Attendance != nil {
+		if err := r.replaceAttendance(ctx, tx, id, update.Attendance); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLMeetingRepository) CloseAttendance(ctx context.Context, id string, closes []AttendanceClose) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	update := r.db.Rebind(
+		"UPDATE attendance SET end_time = ?, auto_closed = ?, auto_close_reason = ? " +
+			"WHERE meeting_id = ? AND position = ? AND registration = ? AND end_time IS NULL",
+	)
+
+	closed := 0
+	for _, pending := range closes {
+		result, err := tx.ExecContext(ctx, update,
+			pending.EndTime.UTC(), true, pending.Reason, id, pending.Position, pending.Registration,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to close attendance: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to close attendance: %w", err)
+		}
+		closed += int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return closed, nil
+}
+
+func (r *SQLMeetingRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE meetings SET deleted_at = ?, updated_at = ? WHERE id = ?"),
+		now, now, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete meeting: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrMeetingNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLMeetingRepository) List(ctx context.Context, query MeetingListQuery) ([]models.Meeting, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+
+	if query.ScheduleID != "" {
+		conditions = append(conditions, "schedule_id = ?")
+		args = append(args, query.ScheduleID)
+	}
+
+	if !query.IncludeDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+
+	if query.From != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, query.From.UTC())
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, query.To.UTC())
+	}
+
+	if query.After != nil {
+		conditions = append(conditions, "(date > ? OR (date = ? AND id > ?))")
+		args = append(args, query.After.Date.UTC(), query.After.Date.UTC(), query.After.ID)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		r.db.Rebind("SELECT "+meetingColumns+" FROM meetings WHERE "+strings.Join(conditions, " AND ")+
+			" ORDER BY date, id LIMIT ?"),
+		append(args, query.Limit)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	return r.scanMeetings(ctx, rows)
+}
+
+func (r *SQLMeetingRepository) ListDeleted(ctx context.Context) ([]models.Meeting, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+meetingColumns+" FROM meetings WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted meetings: %w", err)
+	}
+
+	return r.scanMeetings(ctx, rows)
+}
+
+func (r *SQLMeetingRepository) GetDeletedByID(ctx context.Context, id string) (*models.Meeting, error) {
+	return r.findOne(ctx, "id = ? AND deleted_at IS NOT NULL", id)
+}
+
+func (r *SQLMeetingRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE meetings SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"),
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return meetingConstraintError(err, "failed to restore meeting")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrMeetingNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLMeetingRepository) ListByAttendee(ctx context.Context, registration string) ([]models.Meeting, error) {
+	rows, err := r.db.QueryContext(ctx,
+		r.db.Rebind("SELECT "+meetingColumns+" FROM meetings WHERE id IN "+
+			"(SELECT meeting_id FROM attendance WHERE registration = ?)"),
+		registration,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	return r.scanMeetings(ctx, rows)
+}
+
+func (r *SQLMeetingRepository) HardDelete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, r.db.Rebind("DELETE FROM meetings WHERE id = ?"), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete meeting: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrMeetingNotFound
+	}
+
+	return nil
+}
+
+// scanMeetings reads every row and then loads the attendance of each
+// meeting, rows are closed before the attendance queries run.
+func (r *SQLMeetingRepository) scanMeetings(ctx context.Context, rows *sql.Rows) ([]models.Meeting, error) {
+	meetings := make([]models.Meeting, 0)
+	for rows.Next() {
+		meeting, err := scanMeeting(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to decode meeting: %w", err)
+		}
+		meetings = append(meetings, *meeting)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range meetings {
+		attendance, err := r.loadAttendance(ctx, meetings[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		meetings[i].Attendance = attendance
+	}
+
+	return meetings, nil
+}
+
+func (r *SQLMeetingRepository) findOne(ctx context.Context, condition string, arg any) (*models.Meeting, error) {
+	row := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+meetingColumns+" FROM meetings WHERE "+condition+" LIMIT 1"),
+		arg,
+	)
+
+	meeting, err := scanMeeting(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrMeetingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query meetings: %w", err)
+	}
+
+	meeting.Attendance, err = r.loadAttendance(ctx, meeting.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return meeting, nil
+}
+
+func (r *SQLMeetingRepository) loadAttendance(ctx context.Context, meetingID string) ([]models.Attendance, error) {
+	rows, err := r.db.QueryContext(ctx,
+		r.db.Rebind("SELECT registration, start_time, end_time, auto_closed, auto_close_reason FROM attendance "+
+			"WHERE meeting_id = ? ORDER BY position"),
+		meetingID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attendance: %w", err)
+	}
+	defer rows.Close()
+
+	attendance := make([]models.Attendance, 0)
+	for rows.Next() {
+		var (
+			item    models.Attendance
+			endTime sql.NullTime
+		)
+
+		if err := rows.Scan(&item.Registration, &item.StartTime, &endTime, &item.AutoClosed, &item.AutoCloseReason); err != nil {
+			return nil, fmt.Errorf("failed to decode attendance: %w", err)
+		}
+
+		if endTime.Valid {
+			item.EndTime = &endTime.Time
+		}
+
+		attendance = append(attendance, item)
+	}
+
+	return attendance, rows.Err()
+}
+
+func (r *SQLMeetingRepository) replaceAttendance(ctx context.Context, tx *sql.Tx, meetingID string, attendance []models.Attendance) error {
+	_, err := tx.ExecContext(ctx, r.db.Rebind("DELETE FROM attendance WHERE meeting_id = ?"), meetingID)
+	if err != nil {
+		return fmt.Errorf("failed to clear attendance: %w", err)
+	}
+
+	insert := r.db.Rebind(
+		"INSERT INTO attendance (meeting_id, position, registration, start_time, end_time, auto_closed, auto_close_reason) " +
+			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+	)
+
+	for i, item := range attendance {
+		_, err := tx.ExecContext(ctx, insert,
+			meetingID, i, item.Registration, item.StartTime.UTC(), nullTime(item.EndTime),
+			item.AutoClosed, item.AutoCloseReason,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert attendance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nullTime converts an optional timestamp to a value the drivers store as
+// NULL when it is missing.
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMeeting(row rowScanner) (*models.Meeting, error) {
+	var (
+		meeting       models.Meeting
+		startsAt      sql.NullTime
+		endsAt        sql.NullTime
+		codeRotatedAt sql.NullTime
+		deletedAt     sql.NullTime
+	)
+
+	err := row.Scan(
+		&meeting.ID,
+		&meeting.Date,
+		&meeting.Title,
+		&meeting.Location,
+		&startsAt,
+		&endsAt,
+		&meeting.ScheduleID,
+		&meeting.MeetingCode,
+		&meeting.CodeSecret,
+		&codeRotatedAt,
+		&meeting.CodeRotatedBy,
+		&meeting.CreatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if startsAt.Valid {
+		meeting.StartsAt = &startsAt.Time
+	}
+
+	if endsAt.Valid {
+		meeting.EndsAt = &endsAt.Time
+	}
+
+	if codeRotatedAt.Valid {
+		meeting.CodeRotatedAt = &codeRotatedAt.Time
+	}
+
+	if deletedAt.Valid {
+		meeting.DeletedAt = &deletedAt.Time
+	}
+
+	return &meeting, nil
+}
+
+// meetingConstraintError maps unique index violations to the service errors.
+func meetingConstraintError(err error, message string) error {
+	switch {
+	case database.IsUniqueViolation(err, "meetings", "meeting_code"):
+		return ErrMeetingCodeTaken
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+func newTestSQLRepository(t *testing.T) *SQLMeetingRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLMeetingRepository(db)
+}
+
+func TestSQLMeetingRepositoryAttendance(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	created, err := repo.Create(ctx, models.Meeting{Date: date, MeetingCode: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := date.Add(20 * time.Hour)
+	end := start.Add(time.Hour)
+	attendance := []models.Attendance{
+		{Registration: "2", StartTime: start, EndTime: &end},
+		{Registration: "1", StartTime: start.Add(time.Minute)},
+	}
+
+	if err := repo.Update(ctx, created.ID, MeetingUpdate{Attendance: attendance}); err != nil {
+		t.Fatal(err)
+	}
+
+	meeting, err := repo.GetByCode(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if meeting.ID != created.ID || meeting.MeetingCode != "abc" || len(meeting.Attendance) != 2 {
+		t.Fatalf("GetByCode() = %+v", meeting)
+	}
+
+	for i, want := range attendance {
+		got := meeting.Attendance[i]
+		if got.Registration != want.Registration || !got.StartTime.Equal(want.StartTime) ||
+			(got.EndTime == nil) != (want.EndTime == nil) || got.EndTime != nil && !got.EndTime.Equal(*want.EndTime) {
+			t.Errorf("attendance %d = %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func TestSQLMeetingRepositoryUniqueness(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	first, err := repo.Create(ctx, models.Meeting{Date: date, MeetingCode: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.Create(ctx, models.Meeting{Date: date.AddDate(0, 0, 1), MeetingCode: "abc"}); !errors.Is(err, ErrMeetingCodeTaken) {
+		t.Errorf("Create() with a taken code = %v, want ErrMeetingCodeTaken", err)
+	}
+
+	if _, err := repo.Create(ctx, models.Meeting{Date: date, MeetingCode: "def"}); err != nil {
+		t.Errorf("Create() on the date of another meeting = %v", err)
+	}
+
+	if err := repo.SoftDelete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.GetByID(ctx, first.ID); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("GetByID of deleted meeting = %v, want ErrMeetingNotFound", err)
+	}
+
+	if _, err := repo.Create(ctx, models.Meeting{Date: date, MeetingCode: "abc"}); err != nil {
+		t.Errorf("reusing the code of a deleted meeting: %v", err)
+	}
+
+	meetings, err := repo.ListByDate(ctx, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(meetings) != 2 {
+		t.Errorf("ListByDate() returned %d meetings, want 2", len(meetings))
+	}
+}
+
+func TestSQLMeetingRepositoryMissing(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+
+	if err := repo.Update(ctx, "missing", MeetingUpdate{}); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("Update() = %v, want ErrMeetingNotFound", err)
+	}
+
+	if err := repo.SoftDelete(ctx, "missing"); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("SoftDelete() = %v, want ErrMeetingNotFound", err)
+	}
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// validateTimeSlot checks the optional scheduled times of a meeting. The
+// start must fall on the meeting date in the configured timezone, the end
+// may cross midnight for night sessions.
+func validateTimeSlot(date time.Time, startsAt, endsAt *time.Time) error {
+	if startsAt != nil {
+		day, err := util.LocalDate(*startsAt)
+		if err != nil {
+			return fmt.Errorf("failed to normalize date: %w", err)
+		}
+
+		if !day.Equal(date) {
+			return ErrTimeSlotOutsideDate
+		}
+	}
+
+	if endsAt != nil && (startsAt == nil || !endsAt.After(*startsAt)) {
+		return ErrInvalidTimeSlot
+	}
+
+	return nil
+}
+
+// inProgress reports whether now is within the scheduled time slot.
+func inProgress(meeting *models.Meeting, now time.Time) bool {
+	if meeting.StartsAt == nil || meeting.EndsAt == nil {
+		return false
+	}
+
+	return !now.Before(*meeting.StartsAt) && !now.After(*meeting.EndsAt)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+func TestValidateTimeSlot(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("NITELOG_STORAGE", "memory")
+	t.Setenv("NITELOG_TIMEZONE", "America/Sao_Paulo")
+
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		value := date.Add(time.Duration(hour) * time.Hour)
+		return &value
+	}
+
+	tests := []struct {
+		name     string
+		startsAt *time.Time
+		endsAt   *time.Time
+		want     error
+	}{
+		{"no slot", nil, nil, nil},
+		{"evening", at(19), at(22), nil},
+		{"past midnight", at(22), at(26), nil},
+		{"starts the day before", at(-1), nil, ErrTimeSlotOutsideDate},
+		{"starts the day after", at(24), nil, ErrTimeSlotOutsideDate},
+		{"end without start", nil, at(22), ErrInvalidTimeSlot},
+		{"end before start", at(22), at(19), ErrInvalidTimeSlot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTimeSlot(date, tt.startsAt, tt.endsAt); !errors.Is(err, tt.want) {
+				t.Errorf("validateTimeSlot() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInProgress(t *testing.T) {
+	start := time.Date(2025, time.September, 4, 1, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	meeting := &models.Meeting{StartsAt: &start, EndsAt: &end}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"before", start.Add(-time.Minute), false},
+		{"at start", start, true},
+		{"past midnight", start.Add(2 * time.Hour), true},
+		{"after", end.Add(time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inProgress(meeting, tt.now); got != tt.want {
+				t.Errorf("inProgress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if inProgress(&models.Meeting{StartsAt: &start}, start) {
+		t.Error("inProgress() without an end = true, want false")
+	}
+}
@@ -2,100 +2,111 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"nitelog/internal/models"
-
-	"cloud.google.com/go/firestore"
 )
 
-func (s *MeetingService) Update(ctx context.Context, id string, updatedMeeting models.Meeting) error {
+// Update applies the non zero fields of updatedMeeting and returns the
+// meeting as stored after the change. Moving a meeting to another date
+// moves its time slot along unless a new one is given.
+func (s *MeetingService) Update(ctx context.Context, id string, updatedMeeting models.Meeting) (*models.Meeting, error) {
 	existingMeeting, err := s.GetByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	update := make(map[string]any)
+	var update MeetingUpdate
 	changes := false
 
 	if updatedMeeting.MeetingCode != "" && updatedMeeting.MeetingCode != existingMeeting.MeetingCode {
 		exists, err := s.isMeetingCodeTaken(ctx, updatedMeeting.MeetingCode, id)
 		if err != nil {
-			return fmt.Errorf("meeting code check failed: %w", err)
+			return nil, fmt.Errorf("meeting code check failed: %w", err)
 		}
 		if exists {
-			return ErrMeetingCodeTaken
+			return nil, ErrMeetingCodeTaken
 		}
 
-		update["meetingCode"] = updatedMeeting.MeetingCode
+		update.MeetingCode = &updatedMeeting.MeetingCode
+		changes = true
+	}
+
+	if updatedMeeting.Title != "" && updatedMeeting.Title != existingMeeting.Title {
+		update.Title = &updatedMeeting.Title
 		changes = true
 	}
 
+	if updatedMeeting.Location != "" && updatedMeeting.Location != existingMeeting.Location {
+		update.Location = &updatedMeeting.Location
+		changes = true
+	}
+
+	date := existingMeeting.Date
+	startsAt, endsAt := existingMeeting.StartsAt, existingMeeting.EndsAt
+
 	if !updatedMeeting.Date.IsZero() && !updatedMeeting.Date.Equal(existingMeeting.Date) {
-		exists, err := s.isDateTaken(ctx, updatedMeeting.Date, id)
-		if err != nil {
-			return fmt.Errorf("date check failed: %w", err)
+		date = updatedMeeting.Date
+		update.Date = &date
+		changes = true
+
+		shift := date.Sub(existingMeeting.Date)
+		if startsAt != nil {
+			shifted := startsAt.Add(shift)
+			startsAt = &shifted
 		}
-		if exists {
-			return ErrDateTaken
+		if endsAt != nil {
+			shifted := endsAt.Add(shift)
+			endsAt = &shifted
+		}
+	}
+
+	if updatedMeeting.StartsAt != nil {
+		startsAt = updatedMeeting.StartsAt
+	}
+
+	if updatedMeeting.EndsAt != nil {
+		endsAt = updatedMeeting.EndsAt
+	}
+
+	if !equalOptionalTime(startsAt, existingMeeting.StartsAt) || !equalOptionalTime(endsAt, existingMeeting.EndsAt) {
+		if err := validateTimeSlot(date, startsAt, endsAt); err != nil {
+			return nil, err
 		}
 
-		update["date"] = updatedMeeting.Date
+		update.StartsAt = startsAt
+		update.EndsAt = endsAt
 		changes = true
 	}
 
 	// Check attendance changes
 	if updatedMeeting.Attendance != nil && !equalAttendance(updatedMeeting.Attendance, existingMeeting.Attendance) {
-		update["attendance"] = updatedMeeting.Attendance
+		update.Attendance = updatedMeeting.Attendance
 		changes = true
 	}
 
 	if !changes {
-		return ErrNoChangesDetected
+		return nil, ErrNoChangesDetected
 	}
 
-	// Add timestamp and perform update
-	update["updatedAt"] = firestore.ServerTimestamp
-	_, err = s.collection.Doc(id).Update(ctx, []firestore.Update{
-		{Path: "updatedAt", Value: firestore.ServerTimestamp},
-	})
-
-	return err
-}
-
-func (s *MeetingService) isMeetingCodeTaken(ctx context.Context, code string, excludeID string) (bool, error) {
-	query := s.collection.
-		Where("meetingCode", "==", code).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	if excludeID != "" {
-		query = query.Where(firestore.DocumentID, "!=", excludeID)
+	if err := s.repo.Update(ctx, id, update); err != nil {
+		return nil, err
 	}
 
-	docs, err := query.Documents(ctx).GetAll()
-	if err != nil {
-		return false, fmt.Errorf("firestore query failed: %w", err)
-	}
-	return len(docs) > 0, nil
+	return s.repo.GetByID(ctx, id)
 }
 
-func (s *MeetingService) isDateTaken(ctx context.Context, date time.Time, excludeID string) (bool, error) {
-	query := s.collection.
-		Where("date", "==", date).
-		Where("deletedAt", "==", nil).
-		Limit(1)
-
-	if excludeID != "" {
-		query = query.Where(firestore.DocumentID, "!=", excludeID)
+func (s *MeetingService) isMeetingCodeTaken(ctx context.Context, code string, excludeID string) (bool, error) {
+	meeting, err := s.repo.GetByCode(ctx, code)
+	if errors.Is(err, ErrMeetingNotFound) {
+		return false, nil
 	}
-
-	docs, err := query.Documents(ctx).GetAll()
 	if err != nil {
-		return false, fmt.Errorf("firestore query failed: %w", err)
+		return false, err
 	}
-	return len(docs) > 0, nil
+	return meeting.ID != excludeID, nil
 }
 
 func equalAttendance(a, b []models.Attendance) bool {
@@ -105,14 +116,23 @@ func equalAttendance(a, b []models.Attendance) bool {
 
 	aMap := make(map[string]models.Attendance)
 	for _, item := range a {
-		aMap[item.Registration+item.StartTime.String()] = item
+		aMap[item.Registration+item.StartTime.UTC().Format(time.RFC3339Nano)] = item
 	}
 
 	for _, item := range b {
-		key := item.Registration + item.StartTime.String()
-		if _, exists := aMap[key]; !exists {
+		key := item.Registration + item.StartTime.UTC().Format(time.RFC3339Nano)
+		other, exists := aMap[key]
+		if !exists || !equalOptionalTime(item.EndTime, other.EndTime) {
 			return false
 		}
 	}
 	return true
 }
+
+// equalOptionalTime compares optional timestamps, two missing ones are equal.
+func equalOptionalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
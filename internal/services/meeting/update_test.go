@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+func TestUpdatePersistsChanges(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	created, err := service.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newDate := date.AddDate(0, 0, 1)
+	end := newDate.Add(23 * time.Hour)
+	attendance := []models.Attendance{{Registration: "1", StartTime: newDate.Add(22 * time.Hour), EndTime: &end}}
+
+	updated, err := service.Update(ctx, created.ID, models.Meeting{
+		Date:        newDate,
+		MeetingCode: "renamed",
+		Attendance:  attendance,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := service.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, meeting := range []*models.Meeting{updated, stored} {
+		if !meeting.Date.Equal(newDate) || meeting.MeetingCode != "renamed" || !equalAttendance(meeting.Attendance, attendance) {
+			t.Errorf("got %+v, want the updated meeting", meeting)
+		}
+	}
+
+	if _, err := service.Update(ctx, created.ID, models.Meeting{MeetingCode: "renamed"}); !errors.Is(err, ErrNoChangesDetected) {
+		t.Errorf("Update() without changes = %v, want ErrNoChangesDetected", err)
+	}
+
+	// Closing an attendance is a change even though the entry keeps its start.
+	attendance[0].EndTime = nil
+	if _, err := service.Update(ctx, created.ID, models.Meeting{Attendance: attendance}); err != nil {
+		t.Errorf("Update() of the end time = %v", err)
+	}
+}
+
+func TestUpdateCodeTaken(t *testing.T) {
+	service := newTestMeetingService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	first, err := service.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := service.Create(ctx, models.Meeting{Date: date.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Update(ctx, second.ID, models.Meeting{MeetingCode: first.MeetingCode}); !errors.Is(err, ErrMeetingCodeTaken) {
+		t.Errorf("Update() = %v, want ErrMeetingCodeTaken", err)
+	}
+
+	// Meetings may share a date.
+	if _, err := service.Update(ctx, second.ID, models.Meeting{Date: date}); err != nil {
+		t.Errorf("Update() to the date of another meeting = %v", err)
+	}
+
+	if _, err := service.Update(ctx, "missing", models.Meeting{MeetingCode: "x"}); !errors.Is(err, ErrMeetingNotFound) {
+		t.Errorf("Update() of unknown meeting = %v, want ErrMeetingNotFound", err)
+	}
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"nitelog/internal/mailer"
+	authServices "nitelog/internal/services/auth"
+	userServices "nitelog/internal/services/user"
+)
+
+var (
+	ErrResetNotFound        = errors.New("password reset not found")
+	ErrInvalidResetToken    = errors.New("invalid or expired reset token")
+	ErrTooManyResetRequests = errors.New("too many password reset requests, try again later")
+)
+
+type PasswordService struct {
+	repo        ResetRepository
+	userService *userServices.UserService
+	authService *authServices.AuthService
+	mailer      mailer.Mailer
+	resetURL    string
+	ttl         time.Duration
+	limit       int
+	window      time.Duration
+}
+
+// NewPasswordService emails reset links pointing at resetURL, valid for ttl.
+// At most limit resets can be requested per email within window.
+func NewPasswordService(
+	repo ResetRepository,
+	userService *userServices.UserService,
+	authService *authServices.AuthService,
+	mailer mailer.Mailer,
+	resetURL string,
+	ttl time.Duration,
+	limit int,
+	window time.Duration,
+) *PasswordService {
+	return &PasswordService{
+		repo:        repo,
+		userService: userService,
+		authService: authService,
+		mailer:      mailer,
+		resetURL:    resetURL,
+		ttl:         ttl,
+		limit:       limit,
+		window:      window,
+	}
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FirestoreResetRepository struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreResetRepository(client *firestore.Client) *FirestoreResetRepository {
+	return &FirestoreResetRepository{
+		client:     client,
+		collection: client.Collection("passwordResets"),
+	}
+}
+
+func (r *FirestoreResetRepository) Create(ctx context.Context, reset models.PasswordReset) error {
+	_, err := r.collection.Doc(reset.ID).Create(ctx, reset)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreResetRepository) GetByTokenHash(ctx context.Context, hash string) (*models.PasswordReset, error) {
+	docs, err := r.collection.Where("tokenHash", "==", hash).Limit(1).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query password reset: %w", err)
+	}
+
+	if len(docs) == 0 {
+		return nil, ErrResetNotFound
+	}
+
+	return decodeReset(docs[0])
+}
+
+func (r *FirestoreResetRepository) Use(ctx context.Context, id string, usedAt time.Time) error {
+	ref := r.collection.Doc(id)
+
+	return r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrResetNotFound
+			}
+			return fmt.Errorf("failed to get password reset: %w", err)
+		}
+
+		reset, err := decodeReset(doc)
+		if err != nil {
+			return err
+		}
+
+		if reset.UsedAt != nil {
+			return ErrInvalidResetToken
+		}
+
+		return tx.Update(ref, []firestore.Update{{Path: "usedAt", Value: usedAt}})
+	})
+}
+
+func (r *FirestoreResetRepository) UseAll(ctx context.Context, userID string, usedAt time.Time) (int, error) {
+	iter := r.collection.
+		Where("userId", "==", userID).
+		Where("usedAt", "==", nil).
+		Documents(ctx)
+	defer iter.Stop()
+
+	used := 0
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return used, nil
+		}
+		if err != nil {
+			return used, fmt.Errorf("failed to query password resets: %w", err)
+		}
+
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{{Path: "usedAt", Value: usedAt}}); err != nil {
+			return used, fmt.Errorf("failed to use password reset: %w", err)
+		}
+		used++
+	}
+}
+
+func (r *FirestoreResetRepository) CountSince(ctx context.Context, email string, since time.Time) (int, error) {
+	q := r.collection.
+		Where("email", "==", email).
+		Where("createdAt", ">=", since)
+
+	result, err := q.NewAggregationQuery().WithCount("total").Get(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count password resets: %w", err)
+	}
+
+	total, ok := result["total"].(*firestorepb.Value)
+	if !ok {
+		return 0, fmt.Errorf("failed to count password resets: unexpected result %v", result["total"])
+	}
+
+	return int(total.GetIntegerValue()), nil
+}
+
+func (r *FirestoreResetRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	docs, err := r.collection.Where("expiresAt", "<", before).Documents(ctx).GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to query password resets: %w", err)
+	}
+
+	deleted := 0
+	for _, doc := range docs {
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return deleted, fmt.Errorf("failed to delete password reset: %w", err)
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (r *FirestoreResetRepository) DeleteByUser(ctx context.Context, userID string) (int, error) {
+	docs, err := r.collection.Where("userId", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to query password resets: %w", err)
+	}
+
+	deleted := 0
+	for _, doc := range docs {
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return deleted, fmt.Errorf("failed to delete password reset: %w", err)
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func decodeReset(doc *firestore.DocumentSnapshot) (*models.PasswordReset, error) {
+	var reset models.PasswordReset
+	if err := doc.DataTo(&reset); err != nil {
+		return nil, fmt.Errorf("failed to decode password reset: %w", err)
+	}
+	reset.ID = doc.Ref.ID
+
+	return &reset, nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MemoryResetRepository keeps password resets in process memory. It is meant
+// for local development and tests, all data is lost when the server stops.
+type MemoryResetRepository struct {
+	mu     sync.RWMutex
+	resets map[string]models.PasswordReset
+}
+
+func NewMemoryResetRepository() *MemoryResetRepository {
+	return &MemoryResetRepository{
+		resets: make(map[string]models.PasswordReset),
+	}
+}
+
+func (r *MemoryResetRepository) Create(ctx context.Context, reset models.PasswordReset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.resets[reset.ID] = reset
+
+	return nil
+}
+
+func (r *MemoryResetRepository) GetByTokenHash(ctx context.Context, hash string) (*models.PasswordReset, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, reset := range r.resets {
+		if reset.TokenHash == hash {
+			return &reset, nil
+		}
+	}
+
+	return nil, ErrResetNotFound
+}
+
+func (r *MemoryResetRepository) Use(ctx context.Context, id string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reset, ok := r.resets[id]
+	if !ok {
+		return ErrResetNotFound
+	}
+
+	if reset.UsedAt != nil {
+		return ErrInvalidResetToken
+	}
+
+	reset.UsedAt = &usedAt
+	r.resets[id] = reset
+
+	return nil
+}
+
+func (r *MemoryResetRepository) UseAll(ctx context.Context, userID string, usedAt time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	used := 0
+	for id, reset := range r.resets {
+		if reset.UserID != userID || reset.UsedAt != nil {
+			continue
+		}
+
+		reset.UsedAt = &usedAt
+		r.resets[id] = reset
+		used++
+	}
+
+	return used, nil
+}
+
+func (r *MemoryResetRepository) CountSince(ctx context.Context, email string, since time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, reset := range r.resets {
+		if reset.Email == email && !reset.CreatedAt.Before(since) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *MemoryResetRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, reset := range r.resets {
+		if reset.ExpiresAt.Before(before) {
+			delete(r.resets, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func (r *MemoryResetRepository) DeleteByUser(ctx context.Context, userID string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, reset := range r.resets {
+		if reset.UserID == userID {
+			delete(r.resets, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
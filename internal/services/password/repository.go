@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// ResetRepository is the storage backend used by PasswordService.
+// Implementations return ErrResetNotFound when nothing matches.
+type ResetRepository interface {
+	Create(ctx context.Context, reset models.PasswordReset) error
+	GetByTokenHash(ctx context.Context, hash string) (*models.PasswordReset, error)
+
+	// Use marks the reset as used only if it was not used yet, so two
+	// resets racing with the same token cannot both succeed. A lost race
+	// returns ErrInvalidResetToken.
+	Use(ctx context.Context, id string, usedAt time.Time) error
+
+	// UseAll marks every unused reset of the user as used.
+	UseAll(ctx context.Context, userID string, usedAt time.Time) (int, error)
+
+	// CountSince counts the resets requested for email since the given time.
+	CountSince(ctx context.Context, email string, since time.Time) (int, error)
+
+	// DeleteExpired removes the resets that expired before the given time.
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+
+	// DeleteByUser removes every reset of the user.
+	DeleteByUser(ctx context.Context, userID string) (int, error)
+}
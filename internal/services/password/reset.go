@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"nitelog/internal/mailer"
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+// mailTimeout bounds the delivery of a reset email, which happens after the
+// request that asked for it has returned.
+const mailTimeout = 30 * time.Second
+
+// Forgot emails a reset link to the user owning email. Unknown emails are
+// not reported and take the same path up to the delivery, which happens in
+// the background, so callers cannot tell whether an account exists.
+func (s *PasswordService) Forgot(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	key := strings.ToLower(email)
+	now := time.Now()
+
+	requests, err := s.repo.CountSince(ctx, key, now.Add(-s.window))
+	if err != nil {
+		return err
+	}
+	if requests >= s.limit {
+		return ErrTooManyResetRequests
+	}
+
+	user, err := s.userService.GetByEmail(ctx, email)
+	if err != nil && !errors.Is(err, userServices.ErrUserNotFound) {
+		return err
+	}
+
+	token := util.GenerateToken()
+	reset := models.PasswordReset{
+		ID:        util.GenerateID(),
+		Email:     key,
+		TokenHash: util.HashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+	if user != nil {
+		reset.UserID = user.ID
+	}
+
+	if err := s.repo.Create(ctx, reset); err != nil {
+		return err
+	}
+
+	if user != nil {
+		go s.send(user, token)
+	}
+
+	return nil
+}
+
+func (s *PasswordService) send(user *models.User, token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), mailTimeout)
+	defer cancel()
+
+	link := s.resetURL + "?token=" + url.QueryEscape(token)
+
+	err := s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Redefinição de senha do NITELog",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nRecebemos um pedido para redefinir a sua senha. Para escolher uma nova senha, acesse:\n\n%s\n\n"+
+				"O link expira em %d minutos e só pode ser usado uma vez. Se você não fez este pedido, ignore este email.\n",
+			user.Name, link, int(s.ttl.Minutes()),
+		),
+	})
+	if err != nil {
+		log.Printf("failed to send password reset email to user %s: %v", user.ID, err)
+	}
+}
+
+// Reset sets the password of the user the token was issued to. Every other
+// reset token of the user stops working and every session is logged out.
+func (s *PasswordService) Reset(ctx context.Context, token, password string) error {
+	reset, err := s.repo.GetByTokenHash(ctx, util.HashToken(token))
+	if errors.Is(err, ErrResetNotFound) {
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if reset.UserID == "" || reset.UsedAt != nil || !now.Before(reset.ExpiresAt) {
+		return ErrInvalidResetToken
+	}
+
+	if err := s.repo.Use(ctx, reset.ID, now); err != nil {
+		return err
+	}
+
+	err = s.userService.Update(ctx, reset.UserID, models.User{PasswordHash: password})
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		return ErrInvalidResetToken
+	}
+	if err != nil && !errors.Is(err, userServices.ErrNoChangesDetected) {
+		return err
+	}
+
+	if _, err := s.repo.UseAll(ctx, reset.UserID, now); err != nil {
+		return err
+	}
+
+	_, err = s.authService.LogoutAll(ctx, reset.UserID)
+	return err
+}
+
+// PurgeUser removes the resets of a purged user, they hold the email and
+// would otherwise linger until they expire.
+func (s *PasswordService) PurgeUser(ctx context.Context, userID string) error {
+	_, err := s.repo.DeleteByUser(ctx, userID)
+	return err
+}
+
+// Run is the background job entry point, it removes resets that expired
+// and no longer count towards the rate limit.
+func (s *PasswordService) Run(ctx context.Context) error {
+	deleted, err := s.repo.DeleteExpired(ctx, time.Now().Add(-s.window))
+	if deleted > 0 {
+		log.Printf("deleted %d expired password resets", deleted)
+	}
+
+	return err
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/mailer"
+	authServices "nitelog/internal/services/auth"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+// testMailer hands the emails sent in the background over to the test.
+type testMailer chan mailer.Message
+
+func (m testMailer) Send(ctx context.Context, message mailer.Message) error {
+	m <- message
+	return nil
+}
+
+// token reads the reset token from the link of the next email.
+func (m testMailer) token(t *testing.T) string {
+	t.Helper()
+
+	select {
+	case message := <-m:
+		_, link, ok := strings.Cut(message.Body, "?token=")
+		if !ok {
+			t.Fatalf("email without a reset link: %q", message.Body)
+		}
+
+		token, err := url.QueryUnescape(strings.Fields(link)[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reset email sent")
+		return ""
+	}
+}
+
+type testPasswords struct {
+	passwords *PasswordService
+	users     *userServices.UserService
+	auth      *authServices.AuthService
+	mail      testMailer
+}
+
+func newTestSQLRepository(t *testing.T) *SQLResetRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLResetRepository(db)
+}
+
+var testRepositories = map[string]func(t *testing.T) ResetRepository{
+	"memory": func(t *testing.T) ResetRepository { return NewMemoryResetRepository() },
+	"sqlite": func(t *testing.T) ResetRepository { return newTestSQLRepository(t) },
+}
+
+func newTestPasswordService(t *testing.T, repo ResetRepository, limit int) testPasswords {
+	t.Helper()
+
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	auth := authServices.NewAuthService(authServices.NewMemorySessionRepository(), users, "test", time.Minute, time.Hour, nil)
+	mail := make(testMailer, 10)
+
+	return testPasswords{
+		passwords: NewPasswordService(repo, users, auth, mail, "https://example.com/reset", time.Hour, limit, time.Hour),
+		users:     users,
+		auth:      auth,
+		mail:      mail,
+	}
+}
+
+func TestReset(t *testing.T) {
+	for name, newRepo := range testRepositories {
+		t.Run(name, func(t *testing.T) {
+			svc := newTestPasswordService(t, newRepo(t), 5)
+			ctx := context.Background()
+
+			hash, err := util.HashPassword("old password")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			user, err := svc.users.Create(ctx, "1", "ana@example.com", "Ana", hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			session, err := svc.auth.Login(ctx, user, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := svc.passwords.Forgot(ctx, "ana@example.com"); err != nil {
+				t.Fatal(err)
+			}
+			first := svc.mail.token(t)
+
+			if err := svc.passwords.Forgot(ctx, "ana@example.com"); err != nil {
+				t.Fatal(err)
+			}
+			second := svc.mail.token(t)
+
+			if err := svc.passwords.Reset(ctx, second, "new password"); err != nil {
+				t.Fatal(err)
+			}
+
+			user, err = svc.users.GetByID(ctx, user.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := util.CheckPassword(user.PasswordHash, "new password"); err != nil {
+				t.Errorf("password was not changed: %v", err)
+			}
+
+			// tokens are single use and resetting voids the other tokens of
+			// the user
+			for name, token := range map[string]string{"used": second, "other": first, "unknown": "unknown"} {
+				if err := svc.passwords.Reset(ctx, token, "another password"); !errors.Is(err, ErrInvalidResetToken) {
+					t.Errorf("Reset(%s token) error = %v, want ErrInvalidResetToken", name, err)
+				}
+			}
+
+			if _, err := svc.auth.Refresh(ctx, session.RefreshToken); !errors.Is(err, authServices.ErrInvalidRefreshToken) {
+				t.Errorf("Refresh(after reset) error = %v, want ErrInvalidRefreshToken", err)
+			}
+		})
+	}
+}
+
+func TestForgotRateLimit(t *testing.T) {
+	for name, newRepo := range testRepositories {
+		t.Run(name, func(t *testing.T) {
+			svc := newTestPasswordService(t, newRepo(t), 2)
+			ctx := context.Background()
+
+			// unknown emails count the same, the limit does not tell
+			// whether an account exists
+			for _, email := range []string{"nobody@example.com", "Nobody@example.com"} {
+				if err := svc.passwords.Forgot(ctx, email); err != nil {
+					t.Fatalf("Forgot(%q) error = %v", email, err)
+				}
+			}
+
+			if err := svc.passwords.Forgot(ctx, "nobody@example.com"); !errors.Is(err, ErrTooManyResetRequests) {
+				t.Errorf("Forgot(over the limit) error = %v, want ErrTooManyResetRequests", err)
+			}
+
+			if err := svc.passwords.Forgot(ctx, "other@example.com"); err != nil {
+				t.Errorf("Forgot(other email) error = %v, want the limit to be per email", err)
+			}
+
+			if len(svc.mail) != 0 {
+				t.Errorf("%d emails sent to unknown addresses", len(svc.mail))
+			}
+		})
+	}
+}
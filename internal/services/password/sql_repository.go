@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+const resetColumns = "id, user_id, email, token_hash, created_at, expires_at, used_at"
+
+type SQLResetRepository struct {
+	db *database.DB
+}
+
+func NewSQLResetRepository(db *database.DB) *SQLResetRepository {
+	return &SQLResetRepository{db: db}
+}
+
+func (r *SQLResetRepository) Create(ctx context.Context, reset models.PasswordReset) error {
+	_, err := r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO password_resets ("+resetColumns+") VALUES (?, ?, ?, ?, ?, ?, NULL)"),
+		reset.ID, reset.UserID, reset.Email, reset.TokenHash, reset.CreatedAt.UTC(), reset.ExpiresAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLResetRepository) GetByTokenHash(ctx context.Context, hash string) (*models.PasswordReset, error) {
+	var (
+		reset  models.PasswordReset
+		usedAt sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+resetColumns+" FROM password_resets WHERE token_hash = ?"),
+		hash,
+	).Scan(&reset.ID, &reset.UserID, &reset.Email, &reset.TokenHash, &reset.CreatedAt, &reset.ExpiresAt, &usedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrResetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query password reset: %w", err)
+	}
+
+	if usedAt.Valid {
+		reset.UsedAt = &usedAt.Time
+	}
+
+	return &reset, nil
+}
+
+func (r *SQLResetRepository) Use(ctx context.Context, id string, usedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE password_resets SET used_at = ? WHERE id = ? AND used_at IS NULL"),
+		usedAt.UTC(), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to use password reset: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to use password reset: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrInvalidResetToken
+	}
+
+	return nil
+}
+
+func (r *SQLResetRepository) UseAll(ctx context.Context, userID string, usedAt time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE password_resets SET used_at = ? WHERE user_id = ? AND used_at IS NULL"),
+		usedAt.UTC(), userID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to use password resets: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to use password resets: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+func (r *SQLResetRepository) CountSince(ctx context.Context, email string, since time.Time) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT COUNT(*) FROM password_resets WHERE email = ? AND created_at >= ?"),
+		email, since.UTC(),
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count password resets: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *SQLResetRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("DELETE FROM password_resets WHERE expires_at < ?"),
+		before.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete password resets: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete password resets: %w", err)
+	}
+
+	return int(affected), nil
+}
+
+func (r *SQLResetRepository) DeleteByUser(ctx context.Context, userID string) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("DELETE FROM password_resets WHERE user_id = ?"),
+		userID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete password resets: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete password resets: %w", err)
+	}
+
+	return int(affected), nil
+}
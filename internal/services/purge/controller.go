@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	certificateServices "nitelog/internal/services/certificate"
+	meetingServices "nitelog/internal/services/meeting"
+	passwordServices "nitelog/internal/services/password"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+)
+
+// @model PurgeResult
+type PurgeResult struct {
+	DryRun               bool      `json:"dry_run" example:"false"`
+	DeletedBefore        time.Time `json:"deleted_before" example:"2025-02-14T00:00:00Z"`
+	UsersPurged          int       `json:"users_purged" example:"3"`
+	MeetingsPurged       int       `json:"meetings_purged" example:"1"`
+	AttendanceAnonymized int       `json:"attendance_anonymized" example:"42"`
+	CertificatesPurged   int       `json:"certificates_purged" example:"2"`
+	TwoFactorsPurged     int       `json:"two_factors_purged" example:"1"`
+}
+
+type PurgeService struct {
+	meetingService     *meetingServices.MeetingService
+	userService        *userServices.UserService
+	certificateService *certificateServices.CertificateService
+	twoFactorService   *twoFactorServices.TwoFactorService
+	passwordService    *passwordServices.PasswordService
+	retention          time.Duration
+}
+
+func NewPurgeService(
+	meetingService *meetingServices.MeetingService,
+	userService *userServices.UserService,
+	certificateService *certificateServices.CertificateService,
+	twoFactorService *twoFactorServices.TwoFactorService,
+	passwordService *passwordServices.PasswordService,
+	retention time.Duration,
+) *PurgeService {
+	return &PurgeService{
+		meetingService:     meetingService,
+		userService:        userService,
+		certificateService: certificateService,
+		twoFactorService:   twoFactorService,
+		passwordService:    passwordService,
+		retention:          retention,
+	}
+}
+
+// Purge permanently removes users and meetings soft deleted longer than the
+// retention period. Attendance of purged users is anonymized before the
+// user is removed so meeting history keeps its headcount, certificates,
+// second factors and password resets are removed along with the account.
+func (s *PurgeService) Purge(ctx context.Context, dryRun bool) (*PurgeResult, error) {
+	result := &PurgeResult{
+		DryRun:        dryRun,
+		DeletedBefore: time.Now().Add(-s.retention).UTC(),
+	}
+
+	users, err := s.userService.ListDeletedBefore(ctx, result.DeletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired users: %w", err)
+	}
+
+	for _, user := range users {
+		anonymized, err := s.meetingService.AnonymizeAttendance(
+			ctx,
+			user.Registration,
+			AnonymizedRegistration(user.ID),
+			*user.DeletedAt,
+			dryRun,
+		)
+		result.AttendanceAnonymized += anonymized
+		if err != nil {
+			return result, err
+		}
+
+		purged, err := s.certificateService.PurgeUser(ctx, user.ID, dryRun)
+		result.CertificatesPurged += purged
+		if err != nil {
+			return result, err
+		}
+
+		hadTwoFactor, err := s.twoFactorService.PurgeUser(ctx, user.ID, dryRun)
+		if err != nil {
+			return result, fmt.Errorf("failed to purge two-factor of user %s: %w", user.ID, err)
+		}
+		if hadTwoFactor {
+			result.TwoFactorsPurged++
+		}
+
+		if !dryRun {
+			if err := s.passwordService.PurgeUser(ctx, user.ID); err != nil {
+				return result, fmt.Errorf("failed to purge password resets of user %s: %w", user.ID, err)
+			}
+			if err := s.userService.HardDelete(ctx, user.ID); err != nil {
+				return result, fmt.Errorf("failed to purge user %s: %w", user.ID, err)
+			}
+		}
+		result.UsersPurged++
+	}
+
+	result.MeetingsPurged, err = s.meetingService.PurgeDeleted(ctx, result.DeletedBefore, dryRun)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Run is the background job entry point.
+func (s *PurgeService) Run(ctx context.Context) error {
+	result, err := s.Purge(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if result.UsersPurged > 0 || result.MeetingsPurged > 0 {
+		log.Printf(
+			"purged %d users and %d meetings deleted before %s, %d attendance entries anonymized, %d certificates removed",
+			result.UsersPurged,
+			result.MeetingsPurged,
+			result.DeletedBefore.Format(time.RFC3339),
+			result.AttendanceAnonymized,
+			result.CertificatesPurged,
+		)
+	}
+
+	return nil
+}
+
+// AnonymizedRegistration is the stable pseudonym that replaces the
+// registration of a purged user, entries of the same person still group
+// together without identifying them.
+func AnonymizedRegistration(userID string) string {
+	sum := sha256.Sum256([]byte(userID))
+	return "anonymized-" + hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	certificateServices "nitelog/internal/services/certificate"
+	meetingServices "nitelog/internal/services/meeting"
+	passwordServices "nitelog/internal/services/password"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+)
+
+type testPurge struct {
+	purge        *PurgeService
+	meetings     *meetingServices.MeetingService
+	users        *userServices.UserService
+	certificates certificateServices.CertificateRepository
+	resets       passwordServices.ResetRepository
+	twoFactors   twoFactorServices.TwoFactorRepository
+}
+
+func newTestPurgeService(t *testing.T) testPurge {
+	t.Helper()
+
+	meetings := meetingServices.NewMeetingService(meetingServices.NewMemoryMeetingRepository(), 30*time.Second, "")
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	certificateRepo := certificateServices.NewMemoryCertificateRepository()
+	certificates := certificateServices.NewCertificateService(certificateRepo, meetings, users, time.UTC, "")
+	resetRepo := passwordServices.NewMemoryResetRepository()
+	passwords := passwordServices.NewPasswordService(resetRepo, users, nil, nil, "", time.Hour, 5, time.Hour)
+	twoFactorRepo := twoFactorServices.NewMemoryTwoFactorRepository()
+	twoFactors := twoFactorServices.NewTwoFactorService(twoFactorRepo, users, nil, "", "", time.Minute)
+
+	return testPurge{
+		purge:        NewPurgeService(meetings, users, certificates, twoFactors, passwords, 0),
+		meetings:     meetings,
+		users:        users,
+		certificates: certificateRepo,
+		resets:       resetRepo,
+		twoFactors:   twoFactorRepo,
+	}
+}
+
+func TestPurge(t *testing.T) {
+	svc := newTestPurgeService(t)
+	ctx := context.Background()
+	date := time.Date(2025, time.September, 3, 3, 0, 0, 0, time.UTC)
+
+	user, err := svc.users.Create(ctx, "1", "a@example.com", "Ana", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kept, err := svc.meetings.Create(ctx, models.Meeting{Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.meetings.AddAttendance(ctx, meetingServices.MeetingRef{ID: kept.ID}, user.Registration); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.certificates.Create(ctx, models.Certificate{Code: "K7QX-M4TZ-9PWR", UserID: user.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.twoFactors.Save(ctx, models.TwoFactor{UserID: user.ID, Secret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+
+	reset := models.PasswordReset{
+		ID:        "reset",
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: "hash",
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	if err := svc.resets.Create(ctx, reset); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := svc.meetings.Create(ctx, models.Meeting{Date: date.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.users.SoftDelete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.meetings.SoftDelete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	dryRun, err := svc.purge.Purge(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dryRun.UsersPurged != 1 || dryRun.MeetingsPurged != 1 || dryRun.AttendanceAnonymized != 1 || dryRun.CertificatesPurged != 1 ||
+		dryRun.TwoFactorsPurged != 1 {
+		t.Errorf("dry run = %+v, want one of each", dryRun)
+	}
+
+	if users, _ := svc.users.ListDeleted(ctx); len(users) != 1 {
+		t.Errorf("dry run removed the user")
+	}
+
+	if certificates, _ := svc.certificates.ListByUser(ctx, user.ID); len(certificates) != 1 {
+		t.Errorf("dry run removed the certificate")
+	}
+
+	result, err := svc.purge.Purge(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PurgeResult{
+		DeletedBefore:        result.DeletedBefore,
+		UsersPurged:          1,
+		MeetingsPurged:       1,
+		AttendanceAnonymized: 1,
+		CertificatesPurged:   1,
+		TwoFactorsPurged:     1,
+	}
+	if *result != want {
+		t.Errorf("Purge() = %+v, want one of each", result)
+	}
+
+	if users, _ := svc.users.ListDeleted(ctx); len(users) != 0 {
+		t.Errorf("%d deleted users left after purge", len(users))
+	}
+
+	if meetings, _ := svc.meetings.ListDeleted(ctx); len(meetings) != 0 {
+		t.Errorf("%d deleted meetings left after purge", len(meetings))
+	}
+
+	if certificates, _ := svc.certificates.ListByUser(ctx, user.ID); len(certificates) != 0 {
+		t.Errorf("%d certificates left after purge", len(certificates))
+	}
+
+	if _, err := svc.twoFactors.Get(ctx, user.ID); !errors.Is(err, twoFactorServices.ErrTwoFactorNotFound) {
+		t.Errorf("Get(purged two-factor) error = %v, want ErrTwoFactorNotFound", err)
+	}
+
+	if _, err := svc.resets.GetByTokenHash(ctx, "hash"); !errors.Is(err, passwordServices.ErrResetNotFound) {
+		t.Errorf("GetByTokenHash(purged reset) error = %v, want ErrResetNotFound", err)
+	}
+
+	meeting, err := svc.meetings.GetByID(ctx, kept.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := meeting.Attendance[0].Registration, AnonymizedRegistration(user.ID); got != want {
+		t.Errorf("attendance registration = %q, want %q", got, want)
+	}
+}
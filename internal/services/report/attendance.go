@@ -0,0 +1,190 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+// Attendance aggregates the attendance of the meetings in the period per
+// user. Meetings that have not started yet are left out, times are reported
+// in the configured timezone.
+func (s *ReportService) Attendance(ctx context.Context, opts AttendanceReportOptions) (*AttendanceReport, error) {
+	if opts.From != nil && opts.To != nil && opts.To.Before(*opts.From) {
+		return nil, ErrInvalidDateRange
+	}
+
+	users, err := s.users(ctx, opts.Registration)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := s.heldMeetings(ctx, opts.From, opts.To, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string]*UserAttendance)
+	joined := make(map[string]time.Time)
+	for _, user := range users {
+		rows[user.Registration] = &UserAttendance{Registration: user.Registration, Name: user.Name}
+
+		day, err := util.LocalDate(user.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize date: %w", err)
+		}
+		joined[user.Registration] = *day
+	}
+
+	for _, meeting := range meetings {
+		attended := make(map[string]bool)
+
+		for _, attendance := range meeting.Attendance {
+			if opts.Registration != "" && attendance.Registration != opts.Registration {
+				continue
+			}
+
+			// registrations without a user belong to deleted or purged
+			// accounts, their history still counts
+			row, ok := rows[attendance.Registration]
+			if !ok {
+				row = &UserAttendance{Registration: attendance.Registration}
+				rows[attendance.Registration] = row
+			}
+
+			if !attended[attendance.Registration] {
+				attended[attendance.Registration] = true
+				row.MeetingsAttended++
+			}
+
+			addAttendance(row, attendance, s.location)
+		}
+
+		for registration, row := range rows {
+			since, ok := joined[registration]
+			if ok && !attended[registration] && !meeting.Date.Before(since) {
+				row.Absences++
+			}
+		}
+	}
+
+	if opts.Registration != "" && len(rows) == 0 {
+		return nil, userServices.ErrUserNotFound
+	}
+
+	report := &AttendanceReport{
+		From:         opts.From,
+		To:           opts.To,
+		Timezone:     s.location.String(),
+		MeetingsHeld: len(meetings),
+		Users:        make([]UserAttendance, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		row.MeetingsHeld = row.MeetingsAttended + row.Absences
+		if row.MeetingsAttended > 0 {
+			row.AverageSeconds = row.TotalSeconds / int64(row.MeetingsAttended)
+		}
+		report.Users = append(report.Users, *row)
+	}
+
+	slices.SortFunc(report.Users, func(a, b UserAttendance) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Registration, b.Registration))
+	})
+
+	return report, nil
+}
+
+func addAttendance(row *UserAttendance, attendance models.Attendance, location *time.Location) {
+	start := attendance.StartTime.In(location)
+	if row.FirstAttendance == nil || start.Before(*row.FirstAttendance) {
+		row.FirstAttendance = &start
+	}
+	if row.LastAttendance == nil || start.After(*row.LastAttendance) {
+		row.LastAttendance = &start
+	}
+
+	if attendance.EndTime == nil {
+		row.OpenAttendances++
+		return
+	}
+
+	if attendance.AutoClosed {
+		row.AutoClosed++
+	}
+
+	row.TotalSeconds += int64(attendance.EndTime.Sub(attendance.StartTime).Seconds())
+}
+
+// users returns the registered users the report is about.
+func (s *ReportService) users(ctx context.Context, registration string) ([]models.User, error) {
+	if registration != "" {
+		user, err := s.userService.GetByRegistration(ctx, registration)
+		if errors.Is(err, userServices.ErrUserNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return []models.User{*user}, nil
+	}
+
+	users := make([]models.User, 0)
+	opts := userServices.UserListOptions{Limit: userServices.MaxUserPageSize}
+	for {
+		page, err := s.userService.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page.Users...)
+
+		if page.NextCursor == "" {
+			return users, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+// heldMeetings returns the meetings of the period that had started by now.
+// Meetings without a scheduled start count from the beginning of their day.
+func (s *ReportService) heldMeetings(ctx context.Context, from, to *time.Time, now time.Time) ([]models.Meeting, error) {
+	meetings := make([]models.Meeting, 0)
+	opts := meetingServices.MeetingListOptions{
+		From:  from,
+		To:    to,
+		Limit: meetingServices.MaxMeetingPageSize,
+	}
+
+	for {
+		page, err := s.meetingService.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, meeting := range page.Meetings {
+			start := meeting.Date
+			if meeting.StartsAt != nil {
+				start = *meeting.StartsAt
+			}
+
+			if !start.After(now) {
+				meetings = append(meetings, meeting)
+			}
+		}
+
+		if page.NextCursor == "" {
+			return meetings, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
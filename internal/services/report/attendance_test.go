@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+func TestAttendance(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("NITELOG_STORAGE", "memory")
+	t.Setenv("NITELOG_TIMEZONE", "America/Sao_Paulo")
+
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	meetingRepo := meetingServices.NewMemoryMeetingRepository()
+	meetings := meetingServices.NewMeetingService(meetingRepo, 30*time.Second, "")
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	reports := NewReportService(meetings, users, location)
+
+	for _, user := range []struct{ registration, email, name string }{
+		{"1", "ana@example.com", "Ana"},
+		{"2", "bruno@example.com", "Bruno"},
+	} {
+		if _, err := users.Create(ctx, user.registration, user.email, user.name, []byte("hash")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	today, err := util.LocalDate(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attended, err := meetings.Create(ctx, models.Meeting{Date: *today})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, date := range []time.Time{*today, today.AddDate(0, 0, 1)} {
+		if _, err := meetings.Create(ctx, models.Meeting{Date: date}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := today.Add(time.Hour)
+	end := start.Add(2 * time.Hour)
+	err = meetingRepo.Update(ctx, attended.ID, meetingServices.MeetingUpdate{Attendance: []models.Attendance{
+		{Registration: "1", StartTime: start, EndTime: &end},
+		{Registration: "2", StartTime: start},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := reports.Attendance(ctx, AttendanceReportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the meeting of tomorrow has not been held yet
+	if report.MeetingsHeld != 2 || report.Timezone != "America/Sao_Paulo" {
+		t.Errorf("report = %+v, want 2 meetings held in America/Sao_Paulo", report)
+	}
+
+	if len(report.Users) != 2 {
+		t.Fatalf("len(Users) = %d, want 2", len(report.Users))
+	}
+
+	ana, bruno := report.Users[0], report.Users[1]
+	if ana.MeetingsAttended != 1 || ana.Absences != 1 || ana.TotalSeconds != 7200 || ana.AverageSeconds != 7200 {
+		t.Errorf("Ana = %+v, want one meeting of two hours and one absence", ana)
+	}
+
+	if ana.FirstAttendance == nil || ana.FirstAttendance.Location() != location {
+		t.Errorf("Ana.FirstAttendance = %v, want it in the configured timezone", ana.FirstAttendance)
+	}
+
+	if bruno.MeetingsAttended != 1 || bruno.OpenAttendances != 1 || bruno.TotalSeconds != 0 {
+		t.Errorf("Bruno = %+v, want one open attendance", bruno)
+	}
+
+	single, err := reports.Attendance(ctx, AttendanceReportOptions{Registration: "1"})
+	if err != nil || len(single.Users) != 1 || single.Users[0].Registration != "1" {
+		t.Errorf("Attendance(registration 1) = %+v, %v, want only Ana", single, err)
+	}
+
+	if _, err := reports.Attendance(ctx, AttendanceReportOptions{Registration: "3"}); !errors.Is(err, userServices.ErrUserNotFound) {
+		t.Errorf("Attendance(registration 3) error = %v, want ErrUserNotFound", err)
+	}
+
+	from, to := today.AddDate(0, 0, 1), *today
+	if _, err := reports.Attendance(ctx, AttendanceReportOptions{From: &from, To: &to}); !errors.Is(err, ErrInvalidDateRange) {
+		t.Errorf("Attendance(to before from) error = %v, want ErrInvalidDateRange", err)
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	meetingServices "nitelog/internal/services/meeting"
+	userServices "nitelog/internal/services/user"
+)
+
+var ErrInvalidDateRange = errors.New("to must not be before from")
+
+type ReportService struct {
+	meetingService *meetingServices.MeetingService
+	userService    *userServices.UserService
+	location       *time.Location
+}
+
+func NewReportService(
+	meetingService *meetingServices.MeetingService,
+	userService *userServices.UserService,
+	location *time.Location,
+) *ReportService {
+	return &ReportService{
+		meetingService: meetingService,
+		userService:    userService,
+		location:       location,
+	}
+}
+
+// AttendanceReportOptions selects the meetings of the report by their
+// normalized date, both bounds are inclusive and optional. An empty
+// Registration reports on every user.
+type AttendanceReportOptions struct {
+	From         *time.Time
+	To           *time.Time
+	Registration string
+}
+
+// @model AttendanceReport
+type AttendanceReport struct {
+	From         *time.Time       `json:"from,omitempty" example:"2025-08-01"`
+	To           *time.Time       `json:"to,omitempty" example:"2025-08-31"`
+	Timezone     string           `json:"timezone" example:"America/Sao_Paulo"`
+	MeetingsHeld int              `json:"meetings_held" example:"9"`
+	Users        []UserAttendance `json:"users"`
+}
+
+// UserAttendance sums the finished attendances of a user. Durations are in
+// seconds and the average is per meeting attended, attendances still open
+// are only counted in OpenAttendances.
+// Absences are the meetings held since the user registered that they did
+// not attend.
+//
+// @model UserAttendance
+type UserAttendance struct {
+	Registration     string     `json:"registration" example:"5612879465"`
+	Name             string     `json:"name,omitempty" example:"John Testes"`
+	MeetingsAttended int        `json:"meetings_attended" example:"8"`
+	MeetingsHeld     int        `json:"meetings_held" example:"9"`
+	Absences         int        `json:"absences" example:"1"`
+	TotalSeconds     int64      `json:"total_seconds" example:"86400"`
+	AverageSeconds   int64      `json:"average_seconds" example:"10800"`
+	FirstAttendance  *time.Time `json:"first_attendance,omitempty" example:"2025-08-05T19:02:11-03:00"`
+	LastAttendance   *time.Time `json:"last_attendance,omitempty" example:"2025-08-28T21:58:40-03:00"`
+	OpenAttendances  int        `json:"open_attendances" example:"0"`
+	AutoClosed       int        `json:"auto_closed" example:"2"`
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// CancelOccurrence excludes a date from the schedule and deletes the meeting
+// already created for it. The meeting is soft deleted, so it can still be
+// restored by hand.
+func (s *ScheduleService) CancelOccurrence(ctx context.Context, id string, date time.Time) (*Occurrence, error) {
+	s.materializing.Lock()
+	defer s.materializing.Unlock()
+
+	schedule, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := ParseRule(schedule.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.occurrences(schedule, rule, date, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(found) == 0 {
+		return nil, ErrNotAnOccurrence
+	}
+
+	occurrence := found[0]
+	if occurrence.Cancelled {
+		return nil, ErrAlreadyCancelled
+	}
+
+	if err := s.repo.AddExcludedDate(ctx, id, occurrence.Date); err != nil {
+		return nil, err
+	}
+	occurrence.Cancelled = true
+
+	meetings, err := s.scheduledMeetings(ctx, id, date, date, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, meeting := range meetings[occurrence.Date.Unix()] {
+		if err := s.meetingService.SoftDelete(ctx, meeting.ID); err != nil {
+			return nil, fmt.Errorf("failed to delete meeting %s: %w", meeting.ID, err)
+		}
+	}
+
+	return &occurrence, nil
+}
+
+// Delete stops a schedule from creating meetings. Upcoming meetings nobody
+// attended yet are deleted with it, past ones are kept as history.
+func (s *ScheduleService) Delete(ctx context.Context, id string) error {
+	s.materializing.Lock()
+	defer s.materializing.Unlock()
+
+	if err := s.repo.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+
+	from, to := s.horizonRange(time.Now())
+
+	// meetings are only created inside the horizon, but it may have been
+	// longer when they were
+	to = to.AddDate(1, 0, 0)
+
+	meetings, err := s.scheduledMeetings(ctx, id, from, to, false)
+	if err != nil {
+		return err
+	}
+
+	for _, day := range meetings {
+		for _, meeting := range day {
+			if !upcoming(meeting) {
+				continue
+			}
+
+			if err := s.meetingService.SoftDelete(ctx, meeting.ID); err != nil {
+				return fmt.Errorf("failed to delete meeting %s: %w", meeting.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func upcoming(meeting models.Meeting) bool {
+	return len(meeting.Attendance) == 0 &&
+		(meeting.StartsAt == nil || meeting.StartsAt.After(time.Now()))
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	meetingServices "nitelog/internal/services/meeting"
+)
+
+var (
+	ErrScheduleNotFound  = errors.New("schedule not found")
+	ErrInvalidRule       = errors.New("invalid recurrence rule")
+	ErrInvalidClock      = errors.New("times must use the HH:MM format")
+	ErrInvalidDateRange  = errors.New("end date must not be before start date")
+	ErrNotAnOccurrence   = errors.New("date is not an occurrence of the schedule")
+	ErrAlreadyCancelled  = errors.New("occurrence already cancelled")
+	ErrPreviewRangeLimit = errors.New("preview range must not exceed 366 days")
+)
+
+type ScheduleService struct {
+	repo           ScheduleRepository
+	meetingService *meetingServices.MeetingService
+	horizon        int
+	location       *time.Location
+
+	// materializing serializes meeting creation, the job and the handlers
+	// would otherwise create the same occurrence twice.
+	materializing sync.Mutex
+}
+
+// NewScheduleService creates meetings horizonDays ahead of time, schedule
+// times are wall clock times in location.
+func NewScheduleService(
+	repo ScheduleRepository,
+	meetingService *meetingServices.MeetingService,
+	horizonDays int,
+	location *time.Location,
+) *ScheduleService {
+	return &ScheduleService{
+		repo:           repo,
+		meetingService: meetingService,
+		horizon:        horizonDays,
+		location:       location,
+	}
+}
+
+// @model Occurrence
+type Occurrence struct {
+	Date      time.Time `json:"date" example:"2025-08-05"`
+	StartsAt  time.Time `json:"starts_at" example:"2025-08-05T19:00:00-03:00"`
+	EndsAt    time.Time `json:"ends_at" example:"2025-08-05T22:00:00-03:00"`
+	Cancelled bool      `json:"cancelled,omitempty" example:"false"`
+	MeetingID string    `json:"meeting_id,omitempty" example:"a1b2c3d4e5f6g7h8i9j0k1"`
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// Create stores the schedule and creates its meetings inside the horizon
+// right away. A failure at that point is only logged, the job retries it.
+func (s *ScheduleService) Create(ctx context.Context, schedule models.Schedule) (*models.Schedule, error) {
+	if _, err := validate(&schedule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := s.horizonRange(time.Now())
+	if _, err := s.materialize(ctx, created, from, to); err != nil {
+		log.Printf("failed to create meetings of schedule %s: %v", created.ID, err)
+	}
+
+	return created, nil
+}
+
+func (s *ScheduleService) GetByID(ctx context.Context, id string) (*models.Schedule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *ScheduleService) List(ctx context.Context) ([]models.Schedule, error) {
+	return s.repo.List(ctx)
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FirestoreScheduleRepository struct {
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreScheduleRepository(client *firestore.Client) *FirestoreScheduleRepository {
+	return &FirestoreScheduleRepository{
+		collection: client.Collection("schedules"),
+	}
+}
+
+func (r *FirestoreScheduleRepository) Create(ctx context.Context, schedule models.Schedule) (*models.Schedule, error) {
+	excludedDates := schedule.ExcludedDates
+	if excludedDates == nil {
+		excludedDates = []time.Time{}
+	}
+
+	scheduleRef, _, err := r.collection.Add(ctx, map[string]any{
+		"title":         schedule.Title,
+		"location":      schedule.Location,
+		"rule":          schedule.Rule,
+		"startDate":     schedule.StartDate,
+		"endDate":       schedule.EndDate,
+		"startTime":     schedule.StartTime,
+		"endTime":       schedule.EndTime,
+		"excludedDates": excludedDates,
+		"createdAt":     firestore.ServerTimestamp,
+		"deletedAt":     nil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	doc, err := scheduleRef.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify creation: %w", err)
+	}
+
+	return decodeSchedule(doc)
+}
+
+func (r *FirestoreScheduleRepository) GetByID(ctx context.Context, id string) (*models.Schedule, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	schedule, err := decodeSchedule(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.DeletedAt != nil {
+		return nil, ErrScheduleNotFound
+	}
+
+	return schedule, nil
+}
+
+func (r *FirestoreScheduleRepository) List(ctx context.Context) ([]models.Schedule, error) {
+	docs, err := r.collection.
+		Where("deletedAt", "==", nil).
+		OrderBy("title", firestore.Asc).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedules: %w", err)
+	}
+
+	schedules := make([]models.Schedule, 0, len(docs))
+	for _, doc := range docs {
+		schedule, err := decodeSchedule(doc)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, nil
+}
+
+func (r *FirestoreScheduleRepository) AddExcludedDate(ctx context.Context, id string, date time.Time) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "excludedDates",
+			Value: firestore.ArrayUnion(date),
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrScheduleNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreScheduleRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "deletedAt",
+			Value: firestore.ServerTimestamp,
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrScheduleNotFound
+	}
+
+	return err
+}
+
+func decodeSchedule(doc *firestore.DocumentSnapshot) (*models.Schedule, error) {
+	var schedule models.Schedule
+	if err := doc.DataTo(&schedule); err != nil {
+		return nil, fmt.Errorf("failed to decode schedule: %w", err)
+	}
+
+	schedule.ID = doc.Ref.ID
+	if schedule.ExcludedDates == nil {
+		schedule.ExcludedDates = []time.Time{}
+	}
+
+	return &schedule, nil
+}
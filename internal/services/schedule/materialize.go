@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+)
+
+// Materialize creates the meetings of every active schedule for the next
+// horizon days. Occurrences that already have a meeting, even a deleted one,
+// are skipped so admins can remove a single meeting without it coming back.
+func (s *ScheduleService) Materialize(ctx context.Context) (int, error) {
+	schedules, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	from, to := s.horizonRange(time.Now())
+
+	created := 0
+	var errs []error
+	for i := range schedules {
+		n, err := s.materialize(ctx, &schedules[i], from, to)
+		created += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("schedule %s: %w", schedules[i].ID, err))
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+// Run is the background job entry point.
+func (s *ScheduleService) Run(ctx context.Context) error {
+	created, err := s.Materialize(ctx)
+	if created > 0 {
+		log.Printf("created %d scheduled meetings", created)
+	}
+
+	return err
+}
+
+func (s *ScheduleService) materialize(ctx context.Context, schedule *models.Schedule, from, to time.Time) (int, error) {
+	s.materializing.Lock()
+	defer s.materializing.Unlock()
+
+	rule, err := ParseRule(schedule.Rule)
+	if err != nil {
+		return 0, err
+	}
+
+	occurrences, err := s.occurrences(schedule, rule, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := s.scheduledMeetings(ctx, schedule.ID, from, to, true)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, occurrence := range occurrences {
+		if occurrence.Cancelled || len(existing[occurrence.Date.Unix()]) > 0 {
+			continue
+		}
+
+		_, err := s.meetingService.Create(ctx, models.Meeting{
+			Date:       occurrence.Date,
+			Title:      schedule.Title,
+			Location:   schedule.Location,
+			StartsAt:   &occurrence.StartsAt,
+			EndsAt:     &occurrence.EndsAt,
+			ScheduleID: schedule.ID,
+		})
+		if err != nil {
+			return created, fmt.Errorf("failed to create meeting for %s: %w", occurrence.Date.Format(time.DateOnly), err)
+		}
+		created++
+	}
+
+	return created, nil
+}
+
+// scheduledMeetings returns the meetings created by a schedule between the
+// given dates keyed by the unix time of their date.
+func (s *ScheduleService) scheduledMeetings(
+	ctx context.Context,
+	scheduleID string,
+	from, to time.Time,
+	includeDeleted bool,
+) (map[int64][]models.Meeting, error) {
+	meetings := make(map[int64][]models.Meeting)
+
+	opts := meetingServices.MeetingListOptions{
+		ScheduleID:     scheduleID,
+		From:           &from,
+		To:             &to,
+		IncludeDeleted: includeDeleted,
+		Limit:          meetingServices.MaxMeetingPageSize,
+	}
+
+	for {
+		page, err := s.meetingService.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, meeting := range page.Meetings {
+			key := meeting.Date.Unix()
+			meetings[key] = append(meetings[key], meeting)
+		}
+
+		if page.NextCursor == "" {
+			return meetings, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
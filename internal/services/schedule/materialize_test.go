@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	meetingServices "nitelog/internal/services/meeting"
+)
+
+func newTestScheduleService(t *testing.T) (*ScheduleService, *meetingServices.MeetingService) {
+	t.Helper()
+
+	// Meeting time slots are checked through util.LocalDate, which reads
+	// the timezone with config.Load.
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("NITELOG_STORAGE", "memory")
+	t.Setenv("NITELOG_TIMEZONE", "America/Sao_Paulo")
+
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meetings := meetingServices.NewMeetingService(meetingServices.NewMemoryMeetingRepository(), 30*time.Second, "")
+	return NewScheduleService(NewMemoryScheduleRepository(), meetings, 6, location), meetings
+}
+
+func TestOccurrences(t *testing.T) {
+	service, _ := newTestScheduleService(t)
+	location := service.location
+
+	day := func(d int) time.Time {
+		return time.Date(2025, time.August, d, 0, 0, 0, 0, location).UTC()
+	}
+
+	end := day(14)
+	schedule := &models.Schedule{
+		Rule:          "FREQ=WEEKLY;BYDAY=TU,TH",
+		StartDate:     day(5),
+		EndDate:       &end,
+		StartTime:     "22:00",
+		EndTime:       "01:30",
+		ExcludedDates: []time.Time{day(7)},
+	}
+
+	rule, err := ParseRule(schedule.Rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := service.occurrences(schedule, rule, day(1), day(31))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		date      time.Time
+		cancelled bool
+	}{
+		{day(5), false},
+		{day(7), true},
+		{day(12), false},
+		{day(14), false},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("occurrences() = %+v, want %d occurrences", got, len(want))
+	}
+
+	for i, w := range want {
+		if !got[i].Date.Equal(w.date) || got[i].Cancelled != w.cancelled {
+			t.Errorf("occurrence %d = %+v, want date %s cancelled %v", i, got[i], w.date, w.cancelled)
+		}
+	}
+
+	// Night sessions end on the next day.
+	first := got[0]
+	if first.StartsAt.In(location).Hour() != 22 || first.EndsAt.Sub(first.StartsAt) != 3*time.Hour+30*time.Minute {
+		t.Errorf("first occurrence runs from %s to %s", first.StartsAt, first.EndsAt)
+	}
+}
+
+func TestMaterialize(t *testing.T) {
+	service, meetings := newTestScheduleService(t)
+	ctx := context.Background()
+
+	today, _ := service.horizonRange(time.Now())
+
+	schedule, err := service.Create(ctx, models.Schedule{
+		Title:     "Plantão",
+		Rule:      "FREQ=DAILY",
+		StartDate: today,
+		StartTime: "19:00",
+		EndTime:   "22:00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create materializes the horizon right away, today included.
+	page, err := meetings.List(ctx, meetingServices.MeetingListOptions{ScheduleID: schedule.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Meetings) != 7 {
+		t.Fatalf("Create() made %d meetings, want 7", len(page.Meetings))
+	}
+
+	if err := meetings.SoftDelete(ctx, page.Meetings[1].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := service.Materialize(ctx)
+	if err != nil || created != 0 {
+		t.Errorf("Materialize() = %d, %v, want no new meetings even for the deleted one", created, err)
+	}
+
+	cancelled, err := service.CancelOccurrence(ctx, schedule.ID, page.Meetings[2].Date)
+	if err != nil || !cancelled.Cancelled {
+		t.Fatalf("CancelOccurrence() = %+v, %v", cancelled, err)
+	}
+
+	if _, err := meetings.GetByID(ctx, page.Meetings[2].ID); err == nil {
+		t.Error("the meeting of the cancelled occurrence was not deleted")
+	}
+
+	if _, err := service.CancelOccurrence(ctx, schedule.ID, page.Meetings[2].Date); !errors.Is(err, ErrAlreadyCancelled) {
+		t.Errorf("CancelOccurrence() twice = %v, want ErrAlreadyCancelled", err)
+	}
+
+	if _, err := service.CancelOccurrence(ctx, schedule.ID, today.AddDate(0, 0, -1)); !errors.Is(err, ErrNotAnOccurrence) {
+		t.Errorf("CancelOccurrence() before the start date = %v, want ErrNotAnOccurrence", err)
+	}
+}
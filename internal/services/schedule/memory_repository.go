@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// MemoryScheduleRepository keeps schedules in process memory. It is meant
+// for local development and tests, all data is lost when the server stops.
+type MemoryScheduleRepository struct {
+	mu        sync.RWMutex
+	schedules map[string]models.Schedule
+}
+
+func NewMemoryScheduleRepository() *MemoryScheduleRepository {
+	return &MemoryScheduleRepository{
+		schedules: make(map[string]models.Schedule),
+	}
+}
+
+func (r *MemoryScheduleRepository) Create(ctx context.Context, schedule models.Schedule) (*models.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule.ID = util.GenerateID()
+	schedule.ExcludedDates = slices.Clone(schedule.ExcludedDates)
+	schedule.CreatedAt = time.Now()
+	schedule.DeletedAt = nil
+	r.schedules[schedule.ID] = schedule
+
+	return copySchedule(schedule), nil
+}
+
+func (r *MemoryScheduleRepository) GetByID(ctx context.Context, id string) (*models.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt != nil {
+		return nil, ErrScheduleNotFound
+	}
+
+	return copySchedule(schedule), nil
+}
+
+func (r *MemoryScheduleRepository) List(ctx context.Context) ([]models.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedules := make([]models.Schedule, 0, len(r.schedules))
+	for _, schedule := range r.schedules {
+		if schedule.DeletedAt == nil {
+			schedules = append(schedules, *copySchedule(schedule))
+		}
+	}
+
+	slices.SortFunc(schedules, func(a, b models.Schedule) int {
+		return strings.Compare(a.Title, b.Title)
+	})
+
+	return schedules, nil
+}
+
+func (r *MemoryScheduleRepository) AddExcludedDate(ctx context.Context, id string, date time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt != nil {
+		return ErrScheduleNotFound
+	}
+
+	if !slices.ContainsFunc(schedule.ExcludedDates, date.Equal) {
+		schedule.ExcludedDates = append(slices.Clone(schedule.ExcludedDates), date)
+	}
+	r.schedules[id] = schedule
+
+	return nil
+}
+
+func (r *MemoryScheduleRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt != nil {
+		return ErrScheduleNotFound
+	}
+
+	now := time.Now()
+	schedule.DeletedAt = &now
+	r.schedules[id] = schedule
+
+	return nil
+}
+
+func copySchedule(schedule models.Schedule) *models.Schedule {
+	schedule.ExcludedDates = slices.Clone(schedule.ExcludedDates)
+	if schedule.ExcludedDates == nil {
+		schedule.ExcludedDates = []time.Time{}
+	}
+	return &schedule
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// validate normalizes the user supplied fields of a schedule and returns its
+// parsed rule.
+func validate(schedule *models.Schedule) (*Rule, error) {
+	schedule.Title = strings.TrimSpace(schedule.Title)
+	schedule.Location = strings.TrimSpace(schedule.Location)
+
+	rule, err := ParseRule(schedule.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := parseClock(schedule.StartTime); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := parseClock(schedule.EndTime); err != nil {
+		return nil, err
+	}
+
+	if schedule.EndDate != nil && schedule.EndDate.Before(schedule.StartDate) {
+		return nil, ErrInvalidDateRange
+	}
+
+	return rule, nil
+}
+
+func parseClock(value string) (int, int, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, ErrInvalidClock
+	}
+
+	return clock.Hour(), clock.Minute(), nil
+}
+
+// occurrences expands the schedule between the normalized dates from and
+// to, inclusive. Meetings ending at or before their start time end on the
+// next day, so night sessions can cross midnight.
+func (s *ScheduleService) occurrences(schedule *models.Schedule, rule *Rule, from, to time.Time) ([]Occurrence, error) {
+	location := s.location
+
+	startHour, startMinute, err := parseClock(schedule.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	endHour, endMinute, err := parseClock(schedule.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	first := from.In(location)
+	last := to.In(location)
+	if schedule.EndDate != nil && schedule.EndDate.Before(to) {
+		last = schedule.EndDate.In(location)
+	}
+
+	result := make([]Occurrence, 0)
+	rule.Dates(schedule.StartDate.In(location), last, func(day time.Time) bool {
+		if day.Before(first) {
+			return true
+		}
+
+		year, month, date := day.Date()
+		startsAt := time.Date(year, month, date, startHour, startMinute, 0, 0, location)
+		endsAt := time.Date(year, month, date, endHour, endMinute, 0, 0, location)
+		if !endsAt.After(startsAt) {
+			endsAt = endsAt.AddDate(0, 0, 1)
+		}
+
+		result = append(result, Occurrence{
+			Date:      day.UTC(),
+			StartsAt:  startsAt,
+			EndsAt:    endsAt,
+			Cancelled: slices.ContainsFunc(schedule.ExcludedDates, day.Equal),
+		})
+
+		return true
+	})
+
+	return result, nil
+}
+
+// horizonRange returns today and the last date meetings are created for.
+func (s *ScheduleService) horizonRange(now time.Time) (time.Time, time.Time) {
+	year, month, day := now.In(s.location).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, s.location)
+
+	return today.UTC(), today.AddDate(0, 0, s.horizon).UTC()
+}
+
+func rangeError(from, to time.Time) error {
+	if to.Before(from) {
+		return ErrInvalidDateRange
+	}
+
+	if to.Sub(from) > 366*24*time.Hour {
+		return ErrPreviewRangeLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// Preview expands a schedule that has not been saved yet, so admins can
+// check a rule before creating it.
+func (s *ScheduleService) Preview(schedule models.Schedule, from, to time.Time) ([]Occurrence, error) {
+	if err := rangeError(from, to); err != nil {
+		return nil, err
+	}
+
+	rule, err := validate(&schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.occurrences(&schedule, rule, from, to)
+}
+
+// PreviewByID expands a saved schedule and links each occurrence to the
+// meeting already created for it.
+func (s *ScheduleService) PreviewByID(ctx context.Context, id string, from, to time.Time) ([]Occurrence, error) {
+	if err := rangeError(from, to); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := ParseRule(schedule.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.occurrences(schedule, rule, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	meetings, err := s.scheduledMeetings(ctx, id, from, to, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		if found := meetings[result[i].Date.Unix()]; len(found) > 0 {
+			result[i].MeetingID = found[0].ID
+		}
+	}
+
+	return result, nil
+}
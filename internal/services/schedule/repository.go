@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// ScheduleRepository is the storage backend used by ScheduleService.
+// Implementations must ignore soft deleted schedules and return
+// ErrScheduleNotFound when nothing matches.
+type ScheduleRepository interface {
+	Create(ctx context.Context, schedule models.Schedule) (*models.Schedule, error)
+	GetByID(ctx context.Context, id string) (*models.Schedule, error)
+	List(ctx context.Context) ([]models.Schedule, error)
+	AddExcludedDate(ctx context.Context, id string, date time.Time) error
+	SoftDelete(ctx context.Context, id string) error
+}
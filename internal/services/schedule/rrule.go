@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FreqDaily  = "DAILY"
+	FreqWeekly = "WEEKLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Rule is the subset of RFC 5545 recurrence rules schedules support: FREQ
+// DAILY or WEEKLY, INTERVAL, BYDAY (weekly only), COUNT and UNTIL as a
+// date. Weeks start on Monday, so a biweekly rule is counted from the week
+// of the schedule start date.
+type Rule struct {
+	Freq     string
+	Interval int
+	ByDay    []time.Weekday
+	Count    int
+	Until    *time.Time
+}
+
+// ParseRule parses rules such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH". An
+// optional "RRULE:" prefix is accepted. UNTIL is returned as a calendar date
+// at midnight UTC, callers place it in their own timezone.
+func ParseRule(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || val == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %s given more than once", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			if val != FreqDaily && val != FreqWeekly {
+				return nil, fmt.Errorf("%w: FREQ must be DAILY or WEEKLY", ErrInvalidRule)
+			}
+			rule.Freq = val
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 || interval > 52 {
+				return nil, fmt.Errorf("%w: INTERVAL must be between 1 and 52", ErrInvalidRule)
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("%w: unknown day %q in BYDAY", ErrInvalidRule, day)
+				}
+				if !slices.Contains(rule.ByDay, weekday) {
+					rule.ByDay = append(rule.ByDay, weekday)
+				}
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("%w: COUNT must be a positive number", ErrInvalidRule)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := time.Parse("20060102", val[:min(len(val), 8)])
+			if err != nil {
+				return nil, fmt.Errorf("%w: UNTIL must be a date like 20251216", ErrInvalidRule)
+			}
+			rule.Until = &until
+		case "WKST":
+			if val != "MO" {
+				return nil, fmt.Errorf("%w: only WKST=MO is supported", ErrInvalidRule)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s is not supported", ErrInvalidRule, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+
+	if rule.Freq == FreqDaily && len(rule.ByDay) > 0 {
+		return nil, fmt.Errorf("%w: BYDAY is only supported with FREQ=WEEKLY", ErrInvalidRule)
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot be combined", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// Dates calls yield with every occurrence from start up to last, both local
+// midnights, until yield returns false. COUNT is counted from start even
+// when the occurrences before the wanted range are not yielded.
+func (r *Rule) Dates(start, last time.Time, yield func(day time.Time) bool) {
+	if r.Until != nil {
+		until := time.Date(r.Until.Year(), r.Until.Month(), r.Until.Day(), 0, 0, 0, 0, start.Location())
+		if until.Before(last) {
+			last = until
+		}
+	}
+
+	byDay := r.ByDay
+	if len(byDay) == 0 {
+		byDay = []time.Weekday{start.Weekday()}
+	}
+
+	// days between the Monday of the start week and the start date
+	offset := (int(start.Weekday()) + 6) % 7
+
+	found := 0
+	for i := 0; ; i++ {
+		day := start.AddDate(0, 0, i)
+		if day.After(last) {
+			return
+		}
+
+		var match bool
+		switch r.Freq {
+		case FreqDaily:
+			match = i%r.Interval == 0
+		case FreqWeekly:
+			match = ((i+offset)/7)%r.Interval == 0 && slices.Contains(byDay, day.Weekday())
+		}
+
+		if !match {
+			continue
+		}
+
+		found++
+		if r.Count > 0 && found > r.Count {
+			return
+		}
+
+		if !yield(day) {
+			return
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRule(t *testing.T) {
+	until := time.Date(2025, time.December, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  Rule
+	}{
+		{
+			name:  "daily",
+			value: "FREQ=DAILY",
+			want:  Rule{Freq: FreqDaily, Interval: 1},
+		},
+		{
+			name:  "prefix and lower case",
+			value: " RRULE:freq=weekly;byday=tu,th ",
+			want:  Rule{Freq: FreqWeekly, Interval: 1, ByDay: []time.Weekday{time.Tuesday, time.Thursday}},
+		},
+		{
+			name:  "biweekly",
+			value: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			want:  Rule{Freq: FreqWeekly, Interval: 2, ByDay: []time.Weekday{time.Monday}},
+		},
+		{
+			name:  "repeated day",
+			value: "FREQ=WEEKLY;BYDAY=MO,MO,FR",
+			want:  Rule{Freq: FreqWeekly, Interval: 1, ByDay: []time.Weekday{time.Monday, time.Friday}},
+		},
+		{
+			name:  "count",
+			value: "FREQ=DAILY;COUNT=3",
+			want:  Rule{Freq: FreqDaily, Interval: 1, Count: 3},
+		},
+		{
+			name:  "until date",
+			value: "FREQ=WEEKLY;UNTIL=20251216",
+			want:  Rule{Freq: FreqWeekly, Interval: 1, Until: &until},
+		},
+		{
+			name:  "until date time",
+			value: "FREQ=WEEKLY;UNTIL=20251216T235959Z;WKST=MO",
+			want:  Rule{Freq: FreqWeekly, Interval: 1, Until: &until},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRule(tt.value)
+			if err != nil {
+				t.Fatalf("ParseRule(%q) returned error: %v", tt.value, err)
+			}
+
+			if rule.Freq != tt.want.Freq || rule.Interval != tt.want.Interval || rule.Count != tt.want.Count {
+				t.Errorf("ParseRule(%q) = %+v, want %+v", tt.value, *rule, tt.want)
+			}
+			if !slices.Equal(rule.ByDay, tt.want.ByDay) {
+				t.Errorf("ParseRule(%q) ByDay = %v, want %v", tt.value, rule.ByDay, tt.want.ByDay)
+			}
+			if (rule.Until == nil) != (tt.want.Until == nil) ||
+				rule.Until != nil && !rule.Until.Equal(*tt.want.Until) {
+				t.Errorf("ParseRule(%q) Until = %v, want %v", tt.value, rule.Until, tt.want.Until)
+			}
+		})
+	}
+}
+
+func TestParseRuleInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"RRULE:",
+		"INTERVAL=2",
+		"FREQ=MONTHLY",
+		"FREQ=WEEKLY;FREQ=DAILY",
+		"FREQ=WEEKLY;INTERVAL=0",
+		"FREQ=WEEKLY;INTERVAL=53",
+		"FREQ=WEEKLY;INTERVAL=x",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;UNTIL=2025-12-16",
+		"FREQ=DAILY;COUNT=2;UNTIL=20251216",
+		"FREQ=WEEKLY;WKST=SU",
+		"FREQ=WEEKLY;BYMONTH=1",
+		"FREQ=WEEKLY;BYDAY",
+		"FREQ=WEEKLY;BYDAY=",
+	}
+
+	for _, value := range tests {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseRule(value)
+			if !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("ParseRule(%q) error = %v, want ErrInvalidRule", value, err)
+			}
+		})
+	}
+}
+
+func TestRuleDates(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("timezone data not available: %v", err)
+	}
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, location)
+	}
+
+	// 2025-09-03 is a Wednesday.
+	start := date(2025, time.September, 3)
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		last  time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "daily every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: start,
+			last:  date(2025, time.September, 9),
+			want: []time.Time{
+				date(2025, time.September, 3),
+				date(2025, time.September, 5),
+				date(2025, time.September, 7),
+				date(2025, time.September, 9),
+			},
+		},
+		{
+			name:  "weekly defaults to the start weekday",
+			rule:  "FREQ=WEEKLY",
+			start: start,
+			last:  date(2025, time.September, 20),
+			want: []time.Time{
+				date(2025, time.September, 3),
+				date(2025, time.September, 10),
+				date(2025, time.September, 17),
+			},
+		},
+		{
+			name:  "weekly by day skips days before start",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			start: start,
+			last:  date(2025, time.September, 10),
+			want: []time.Time{
+				date(2025, time.September, 3),
+				date(2025, time.September, 5),
+				date(2025, time.September, 8),
+				date(2025, time.September, 10),
+			},
+		},
+		{
+			// The Monday of the start week is 2025-09-01, so the week of
+			// 09-08 is skipped even though it is less than 14 days away.
+			name:  "biweekly counts weeks from the start week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start: start,
+			last:  date(2025, time.September, 30),
+			want: []time.Time{
+				date(2025, time.September, 5),
+				date(2025, time.September, 15),
+				date(2025, time.September, 19),
+				date(2025, time.September, 29),
+			},
+		},
+		{
+			name:  "count",
+			rule:  "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=3",
+			start: start,
+			last:  date(2025, time.December, 31),
+			want: []time.Time{
+				date(2025, time.September, 4),
+				date(2025, time.September, 9),
+				date(2025, time.September, 11),
+			},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  "FREQ=DAILY;UNTIL=20250905",
+			start: start,
+			last:  date(2025, time.December, 31),
+			want: []time.Time{
+				date(2025, time.September, 3),
+				date(2025, time.September, 4),
+				date(2025, time.September, 5),
+			},
+		},
+		{
+			name:  "last before until",
+			rule:  "FREQ=DAILY;UNTIL=20251231",
+			start: start,
+			last:  date(2025, time.September, 4),
+			want: []time.Time{
+				date(2025, time.September, 3),
+				date(2025, time.September, 4),
+			},
+		},
+		{
+			name:  "until before start",
+			rule:  "FREQ=DAILY;UNTIL=20250901",
+			start: start,
+			last:  date(2025, time.December, 31),
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRule(%q) returned error: %v", tt.rule, err)
+			}
+
+			var got []time.Time
+			rule.Dates(tt.start, tt.last, func(day time.Time) bool {
+				got = append(got, day)
+				return true
+			})
+
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("Dates(%q) = %v, want %v", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleDatesStopsWhenYieldReturnsFalse(t *testing.T) {
+	rule, err := ParseRule("FREQ=DAILY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2025, time.September, 3, 0, 0, 0, 0, time.UTC)
+
+	calls := 0
+	rule.Dates(start, start.AddDate(1, 0, 0), func(day time.Time) bool {
+		calls++
+		return calls < 2
+	})
+
+	if calls != 2 {
+		t.Errorf("yield called %d times, want 2", calls)
+	}
+}
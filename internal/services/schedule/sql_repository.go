@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const scheduleColumns = "id, title, location, rule, start_date, end_date, start_time, end_time, " +
+	"excluded_dates, created_at, deleted_at"
+
+type SQLScheduleRepository struct {
+	db *database.DB
+}
+
+func NewSQLScheduleRepository(db *database.DB) *SQLScheduleRepository {
+	return &SQLScheduleRepository{db: db}
+}
+
+func (r *SQLScheduleRepository) Create(ctx context.Context, schedule models.Schedule) (*models.Schedule, error) {
+	if schedule.ExcludedDates == nil {
+		schedule.ExcludedDates = []time.Time{}
+	}
+
+	excludedDates, err := json.Marshal(schedule.ExcludedDates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode excluded dates: %w", err)
+	}
+
+	schedule.ID = util.GenerateID()
+	schedule.StartDate = schedule.StartDate.UTC()
+	schedule.CreatedAt = time.Now().UTC()
+	schedule.DeletedAt = nil
+
+	var endDate any
+	if schedule.EndDate != nil {
+		endDate = schedule.EndDate.UTC()
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO schedules ("+scheduleColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULL)"),
+		schedule.ID, schedule.Title, schedule.Location, schedule.Rule, schedule.StartDate, endDate,
+		schedule.StartTime, schedule.EndTime, string(excludedDates), schedule.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+func (r *SQLScheduleRepository) GetByID(ctx context.Context, id string) (*models.Schedule, error) {
+	row := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+scheduleColumns+" FROM schedules WHERE id = ? AND deleted_at IS NULL"),
+		id,
+	)
+
+	schedule, err := scanSchedule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrScheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (r *SQLScheduleRepository) List(ctx context.Context) ([]models.Schedule, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+scheduleColumns+" FROM schedules WHERE deleted_at IS NULL ORDER BY title",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]models.Schedule, 0)
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode schedule: %w", err)
+		}
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+// AddExcludedDate rewrites the JSON list inside a transaction, SQLite and
+// Postgres have no common way to append to it in place.
+func (r *SQLScheduleRepository) AddExcludedDate(ctx context.Context, id string, date time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var raw string
+	err = tx.QueryRowContext(ctx,
+		r.db.Rebind("SELECT excluded_dates FROM schedules WHERE id = ? AND deleted_at IS NULL"),
+		id,
+	).Scan(&raw)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrScheduleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query schedule: %w", err)
+	}
+
+	var excludedDates []time.Time
+	if err := json.Unmarshal([]byte(raw), &excludedDates); err != nil {
+		return fmt.Errorf("failed to decode excluded dates: %w", err)
+	}
+
+	if slices.ContainsFunc(excludedDates, date.Equal) {
+		return tx.Commit()
+	}
+
+	encoded, err := json.Marshal(append(excludedDates, date.UTC()))
+	if err != nil {
+		return fmt.Errorf("failed to encode excluded dates: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		r.db.Rebind("UPDATE schedules SET excluded_dates = ?, updated_at = ? WHERE id = ?"),
+		string(encoded), time.Now().UTC(), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLScheduleRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE schedules SET deleted_at = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL"),
+		now, now, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrScheduleNotFound
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSchedule(row rowScanner) (*models.Schedule, error) {
+	var (
+		schedule      models.Schedule
+		endDate       sql.NullTime
+		excludedDates string
+		deletedAt     sql.NullTime
+	)
+
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.Title,
+		&schedule.Location,
+		&schedule.Rule,
+		&schedule.StartDate,
+		&endDate,
+		&schedule.StartTime,
+		&schedule.EndTime,
+		&excludedDates,
+		&schedule.CreatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(excludedDates), &schedule.ExcludedDates); err != nil {
+		return nil, fmt.Errorf("failed to decode excluded dates: %w", err)
+	}
+
+	if endDate.Valid {
+		schedule.EndDate = &endDate.Time
+	}
+
+	if deletedAt.Valid {
+		schedule.DeletedAt = &deletedAt.Time
+	}
+
+	return &schedule, nil
+}
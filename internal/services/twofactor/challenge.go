@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// challengeAudience keeps challenges from being accepted as access tokens
+// or as other signed tokens, all of them share the same secret. Challenges
+// carry no token id, which the access token middleware refuses too.
+const challengeAudience = "two-factor-challenge"
+
+// Challenge returns the token that stands for the password step of the
+// login, to be exchanged with Verify.
+func (s *TwoFactorService) Challenge(user *models.User) (*TwoFactorChallenge, error) {
+	now := time.Now()
+	claims := jwt.StandardClaims{
+		Audience:  challengeAudience,
+		Subject:   user.ID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.challengeTTL).Unix(),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.secret))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorChallenge{
+		TwoFactorRequired: true,
+		ChallengeToken:    token,
+		ExpiresIn:         int(s.challengeTTL.Seconds()),
+	}, nil
+}
+
+// Verify completes the login started by Challenge, code is either a TOTP
+// code or a recovery code.
+func (s *TwoFactorService) Verify(ctx context.Context, challenge, code string) (*models.User, error) {
+	claims := &jwt.StandardClaims{}
+	_, err := jwt.ParseWithClaims(challenge, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(s.secret), nil
+	})
+	if err != nil || !claims.VerifyAudience(challengeAudience, true) {
+		return nil, ErrInvalidChallenge
+	}
+
+	user, err := s.userService.GetByID(ctx, claims.Subject)
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		return nil, ErrInvalidChallenge
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := s.enabled(ctx, user.ID)
+	if errors.Is(err, ErrTwoFactorNotEnabled) {
+		return nil, ErrInvalidChallenge
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.check(ctx, twoFactor, code, true); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	authServices "nitelog/internal/services/auth"
+	userServices "nitelog/internal/services/user"
+)
+
+var (
+	ErrTwoFactorNotFound   = errors.New("two-factor authentication not set up")
+	ErrTwoFactorEnabled    = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorRequired   = errors.New("two-factor authentication is required for the roles of the user")
+	ErrInvalidCode         = errors.New("invalid two-factor code")
+	ErrCodeAlreadyUsed     = errors.New("two-factor code already used")
+	ErrTwoFactorLocked     = errors.New("too many invalid two-factor codes, try again later")
+	ErrInvalidChallenge    = errors.New("invalid or expired two-factor challenge")
+)
+
+// TwoFactorService manages TOTP second factors and the second step of the
+// login. Challenges are signed rather than stored, they only prove the
+// password step was passed.
+type TwoFactorService struct {
+	repo         TwoFactorRepository
+	userService  *userServices.UserService
+	authService  *authServices.AuthService
+	secret       string
+	issuer       string
+	challengeTTL time.Duration
+}
+
+// NewTwoFactorService names issuer in authenticator apps. Challenges are
+// valid for challengeTTL.
+func NewTwoFactorService(
+	repo TwoFactorRepository,
+	userService *userServices.UserService,
+	authService *authServices.AuthService,
+	secret string,
+	issuer string,
+	challengeTTL time.Duration,
+) *TwoFactorService {
+	return &TwoFactorService{
+		repo:         repo,
+		userService:  userService,
+		authService:  authService,
+		secret:       secret,
+		issuer:       issuer,
+		challengeTTL: challengeTTL,
+	}
+}
+
+// @model TwoFactorEnrollment
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	URI    string `json:"uri" example:"otpauth://totp/NITELog:ana@example.com?secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP&issuer=NITELog"`
+	QRCode string `json:"qr_code,omitempty" example:"data:image/png;base64,iVBORw0KGgo..."`
+}
+
+// @model TwoFactorChallenge
+type TwoFactorChallenge struct {
+	TwoFactorRequired bool   `json:"two_factor_required" example:"true"`
+	ChallengeToken    string `json:"challenge_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	ExpiresIn         int    `json:"expires_in" example:"300"`
+}
+
+// @model TwoFactorStatus
+type TwoFactorStatus struct {
+	Enabled           bool       `json:"enabled" example:"true"`
+	Pending           bool       `json:"pending" example:"false"`
+	Required          bool       `json:"required" example:"true"`
+	EnabledAt         *time.Time `json:"enabled_at,omitempty" example:"2025-08-01T12:00:00Z"`
+	RecoveryCodesLeft int        `json:"recovery_codes_left" example:"8"`
+}
+
+// @model RecoveryCodes
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"K7QX-M4TZ-9PLA"`
+}
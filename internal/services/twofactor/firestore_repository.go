@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FirestoreTwoFactorRepository uses the user id as document id, a user has
+// at most one second factor.
+type FirestoreTwoFactorRepository struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreTwoFactorRepository(client *firestore.Client) *FirestoreTwoFactorRepository {
+	return &FirestoreTwoFactorRepository{
+		client:     client,
+		collection: client.Collection("twoFactors"),
+	}
+}
+
+func (r *FirestoreTwoFactorRepository) Get(ctx context.Context, userID string) (*models.TwoFactor, error) {
+	doc, err := r.collection.Doc(userID).Get(ctx)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrTwoFactorNotFound
+		}
+		return nil, fmt.Errorf("failed to get two-factor: %w", err)
+	}
+
+	return decodeTwoFactor(doc)
+}
+
+func (r *FirestoreTwoFactorRepository) Save(ctx context.Context, twoFactor models.TwoFactor) error {
+	if _, err := r.collection.Doc(twoFactor.UserID).Set(ctx, twoFactor); err != nil {
+		return fmt.Errorf("failed to save two-factor: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreTwoFactorRepository) Delete(ctx context.Context, userID string) error {
+	_, err := r.collection.Doc(userID).Delete(ctx, firestore.Exists)
+
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return ErrTwoFactorNotFound
+		}
+		return fmt.Errorf("failed to delete two-factor: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FirestoreTwoFactorRepository) UseCounter(ctx context.Context, userID string, counter int64) error {
+	return r.update(ctx, userID, func(twoFactor *models.TwoFactor) ([]firestore.Update, error) {
+		if counter <= twoFactor.LastCounter {
+			return nil, ErrCodeAlreadyUsed
+		}
+		return []firestore.Update{
+			{Path: "lastCounter", Value: counter},
+			{Path: "failedAttempts", Value: 0},
+		}, nil
+	})
+}
+
+func (r *FirestoreTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID, hash string) error {
+	return r.update(ctx, userID, func(twoFactor *models.TwoFactor) ([]firestore.Update, error) {
+		i := slices.Index(twoFactor.RecoveryCodes, hash)
+		if i < 0 {
+			return nil, ErrInvalidCode
+		}
+		return []firestore.Update{
+			{Path: "recoveryCodes", Value: slices.Delete(twoFactor.RecoveryCodes, i, i+1)},
+			{Path: "failedAttempts", Value: 0},
+		}, nil
+	})
+}
+
+func (r *FirestoreTwoFactorRepository) SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error {
+	return r.update(ctx, userID, func(twoFactor *models.TwoFactor) ([]firestore.Update, error) {
+		return []firestore.Update{{Path: "recoveryCodes", Value: hashes}}, nil
+	})
+}
+
+func (r *FirestoreTwoFactorRepository) RecordFailure(
+	ctx context.Context,
+	userID string,
+	maxAttempts int,
+	lockedUntil time.Time,
+) error {
+	return r.update(ctx, userID, func(twoFactor *models.TwoFactor) ([]firestore.Update, error) {
+		if twoFactor.FailedAttempts+1 >= maxAttempts {
+			return []firestore.Update{
+				{Path: "failedAttempts", Value: 0},
+				{Path: "lockedUntil", Value: lockedUntil},
+			}, nil
+		}
+		return []firestore.Update{{Path: "failedAttempts", Value: firestore.Increment(1)}}, nil
+	})
+}
+
+// update applies the changes returned by change in a transaction.
+func (r *FirestoreTwoFactorRepository) update(
+	ctx context.Context,
+	userID string,
+	change func(twoFactor *models.TwoFactor) ([]firestore.Update, error),
+) error {
+	ref := r.collection.Doc(userID)
+
+	return r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ErrTwoFactorNotFound
+			}
+			return fmt.Errorf("failed to get two-factor: %w", err)
+		}
+
+		twoFactor, err := decodeTwoFactor(doc)
+		if err != nil {
+			return err
+		}
+
+		updates, err := change(twoFactor)
+		if err != nil || len(updates) == 0 {
+			return err
+		}
+
+		return tx.Update(ref, updates)
+	})
+}
+
+func decodeTwoFactor(doc *firestore.DocumentSnapshot) (*models.TwoFactor, error) {
+	var twoFactor models.TwoFactor
+	if err := doc.DataTo(&twoFactor); err != nil {
+		return nil, fmt.Errorf("failed to decode two-factor: %w", err)
+	}
+	twoFactor.UserID = doc.Ref.ID
+
+	return &twoFactor, nil
+}
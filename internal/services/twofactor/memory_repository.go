@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// MemoryTwoFactorRepository keeps second factors in process memory. It is
+// meant for local development and tests, all data is lost when the server
+// stops.
+type MemoryTwoFactorRepository struct {
+	mu         sync.RWMutex
+	twoFactors map[string]models.TwoFactor
+}
+
+func NewMemoryTwoFactorRepository() *MemoryTwoFactorRepository {
+	return &MemoryTwoFactorRepository{
+		twoFactors: make(map[string]models.TwoFactor),
+	}
+}
+
+func (r *MemoryTwoFactorRepository) Get(ctx context.Context, userID string) (*models.TwoFactor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	twoFactor, ok := r.twoFactors[userID]
+	if !ok {
+		return nil, ErrTwoFactorNotFound
+	}
+
+	twoFactor.RecoveryCodes = slices.Clone(twoFactor.RecoveryCodes)
+
+	return &twoFactor, nil
+}
+
+func (r *MemoryTwoFactorRepository) Save(ctx context.Context, twoFactor models.TwoFactor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	twoFactor.RecoveryCodes = slices.Clone(twoFactor.RecoveryCodes)
+	r.twoFactors[twoFactor.UserID] = twoFactor
+
+	return nil
+}
+
+func (r *MemoryTwoFactorRepository) Delete(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.twoFactors[userID]; !ok {
+		return ErrTwoFactorNotFound
+	}
+
+	delete(r.twoFactors, userID)
+
+	return nil
+}
+
+func (r *MemoryTwoFactorRepository) UseCounter(ctx context.Context, userID string, counter int64) error {
+	return r.update(userID, func(twoFactor *models.TwoFactor) error {
+		if counter <= twoFactor.LastCounter {
+			return ErrCodeAlreadyUsed
+		}
+
+		twoFactor.LastCounter = counter
+		twoFactor.FailedAttempts = 0
+		return nil
+	})
+}
+
+func (r *MemoryTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID, hash string) error {
+	return r.update(userID, func(twoFactor *models.TwoFactor) error {
+		i := slices.Index(twoFactor.RecoveryCodes, hash)
+		if i < 0 {
+			return ErrInvalidCode
+		}
+
+		twoFactor.RecoveryCodes = slices.Delete(slices.Clone(twoFactor.RecoveryCodes), i, i+1)
+		twoFactor.FailedAttempts = 0
+		return nil
+	})
+}
+
+func (r *MemoryTwoFactorRepository) SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error {
+	return r.update(userID, func(twoFactor *models.TwoFactor) error {
+		twoFactor.RecoveryCodes = slices.Clone(hashes)
+		return nil
+	})
+}
+
+func (r *MemoryTwoFactorRepository) RecordFailure(
+	ctx context.Context,
+	userID string,
+	maxAttempts int,
+	lockedUntil time.Time,
+) error {
+	return r.update(userID, func(twoFactor *models.TwoFactor) error {
+		twoFactor.FailedAttempts++
+		if twoFactor.FailedAttempts >= maxAttempts {
+			twoFactor.FailedAttempts = 0
+			twoFactor.LockedUntil = &lockedUntil
+		}
+		return nil
+	})
+}
+
+func (r *MemoryTwoFactorRepository) update(userID string, change func(twoFactor *models.TwoFactor) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	twoFactor, ok := r.twoFactors[userID]
+	if !ok {
+		return ErrTwoFactorNotFound
+	}
+
+	if err := change(&twoFactor); err != nil {
+		return err
+	}
+
+	r.twoFactors[userID] = twoFactor
+
+	return nil
+}
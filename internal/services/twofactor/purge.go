@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+	"errors"
+)
+
+// PurgeUser removes the second factor of a purged user, its secret and
+// recovery code hashes are not tied to the user row. It reports whether
+// there was (or, on a dry run, is) one to remove.
+func (s *TwoFactorService) PurgeUser(ctx context.Context, userID string, dryRun bool) (bool, error) {
+	if dryRun {
+		_, err := s.repo.Get(ctx, userID)
+		if errors.Is(err, ErrTwoFactorNotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	err := s.repo.Delete(ctx, userID)
+	if errors.Is(err, ErrTwoFactorNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// TwoFactorRepository is the storage backend used by TwoFactorService.
+// Implementations return ErrTwoFactorNotFound when the user has no second
+// factor.
+type TwoFactorRepository interface {
+	Get(ctx context.Context, userID string) (*models.TwoFactor, error)
+
+	// Save creates or replaces the second factor of the user.
+	Save(ctx context.Context, twoFactor models.TwoFactor) error
+	Delete(ctx context.Context, userID string) error
+
+	// UseCounter records a code of the time step counter and clears the
+	// failed attempts. A step not newer than the last used one returns
+	// ErrCodeAlreadyUsed, so a code works once.
+	UseCounter(ctx context.Context, userID string, counter int64) error
+
+	// UseRecoveryCode removes the recovery code hash and clears the failed
+	// attempts. A hash the user does not hold returns ErrInvalidCode.
+	UseRecoveryCode(ctx context.Context, userID, hash string) error
+	SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error
+
+	// RecordFailure counts a wrong code. The maxAttempts-th failure in a
+	// row locks the second factor until lockedUntil and resets the count.
+	RecordFailure(ctx context.Context, userID string, maxAttempts int, lockedUntil time.Time) error
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+func newTestSQLRepository(t *testing.T) *SQLTwoFactorRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLTwoFactorRepository(db)
+}
+
+func TestTwoFactorRepository(t *testing.T) {
+	repos := map[string]func(t *testing.T) TwoFactorRepository{
+		"memory": func(t *testing.T) TwoFactorRepository { return NewMemoryTwoFactorRepository() },
+		"sqlite": func(t *testing.T) TwoFactorRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			ctx := context.Background()
+			now := time.Now().UTC().Truncate(time.Second)
+
+			err := repo.Save(ctx, models.TwoFactor{
+				UserID:        testUserID,
+				Secret:        "secret",
+				RecoveryCodes: []string{"a", "b"},
+				CreatedAt:     now,
+				EnabledAt:     &now,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := repo.UseCounter(ctx, testUserID, 10); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, counter := range []int64{10, 9} {
+				if err := repo.UseCounter(ctx, testUserID, counter); !errors.Is(err, ErrCodeAlreadyUsed) {
+					t.Errorf("UseCounter(%d) error = %v, want ErrCodeAlreadyUsed", counter, err)
+				}
+			}
+
+			if err := repo.UseRecoveryCode(ctx, testUserID, "a"); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := repo.UseRecoveryCode(ctx, testUserID, "a"); !errors.Is(err, ErrInvalidCode) {
+				t.Errorf("UseRecoveryCode(used) error = %v, want ErrInvalidCode", err)
+			}
+
+			stored, err := repo.Get(ctx, testUserID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if stored.LastCounter != 10 || !slices.Equal(stored.RecoveryCodes, []string{"b"}) {
+				t.Errorf("Get() = %+v, want counter 10 and recovery code b left", stored)
+			}
+
+			if err := repo.Delete(ctx, testUserID); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := repo.Get(ctx, testUserID); !errors.Is(err, ErrTwoFactorNotFound) {
+				t.Errorf("Get(deleted) error = %v, want ErrTwoFactorNotFound", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+const twoFactorColumns = "user_id, secret, recovery_codes, last_counter, failed_attempts, locked_until, created_at, enabled_at"
+
+type SQLTwoFactorRepository struct {
+	db *database.DB
+}
+
+func NewSQLTwoFactorRepository(db *database.DB) *SQLTwoFactorRepository {
+	return &SQLTwoFactorRepository{db: db}
+}
+
+func (r *SQLTwoFactorRepository) Get(ctx context.Context, userID string) (*models.TwoFactor, error) {
+	var (
+		twoFactor     models.TwoFactor
+		recoveryCodes string
+		lockedUntil   sql.NullTime
+		enabledAt     sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+twoFactorColumns+" FROM two_factor WHERE user_id = ?"),
+		userID,
+	).Scan(
+		&twoFactor.UserID, &twoFactor.Secret, &recoveryCodes, &twoFactor.LastCounter, &twoFactor.FailedAttempts,
+		&lockedUntil, &twoFactor.CreatedAt, &enabledAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTwoFactorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query two-factor: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(recoveryCodes), &twoFactor.RecoveryCodes); err != nil {
+		return nil, fmt.Errorf("failed to decode recovery codes of user %s: %w", userID, err)
+	}
+	if lockedUntil.Valid {
+		twoFactor.LockedUntil = &lockedUntil.Time
+	}
+	if enabledAt.Valid {
+		twoFactor.EnabledAt = &enabledAt.Time
+	}
+
+	return &twoFactor, nil
+}
+
+func (r *SQLTwoFactorRepository) Save(ctx context.Context, twoFactor models.TwoFactor) error {
+	recoveryCodes, err := encodeRecoveryCodes(twoFactor.RecoveryCodes)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO two_factor ("+twoFactorColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?) "+
+			"ON CONFLICT (user_id) DO UPDATE SET secret = excluded.secret, recovery_codes = excluded.recovery_codes, "+
+			"last_counter = excluded.last_counter, failed_attempts = excluded.failed_attempts, "+
+			"locked_until = excluded.locked_until, created_at = excluded.created_at, enabled_at = excluded.enabled_at"),
+		twoFactor.UserID, twoFactor.Secret, recoveryCodes, twoFactor.LastCounter, twoFactor.FailedAttempts,
+		nullTime(twoFactor.LockedUntil), twoFactor.CreatedAt.UTC(), nullTime(twoFactor.EnabledAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLTwoFactorRepository) Delete(ctx context.Context, userID string) error {
+	result, err := r.db.ExecContext(ctx, r.db.Rebind("DELETE FROM two_factor WHERE user_id = ?"), userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete two-factor: %w", err)
+	}
+
+	return r.requireAffected(result, "failed to delete two-factor")
+}
+
+func (r *SQLTwoFactorRepository) UseCounter(ctx context.Context, userID string, counter int64) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE two_factor SET last_counter = ?, failed_attempts = 0 WHERE user_id = ? AND last_counter < ?"),
+		counter, userID, counter,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to use two-factor code: %w", err)
+	}
+
+	err = r.requireAffected(result, "failed to use two-factor code")
+	if errors.Is(err, ErrTwoFactorNotFound) {
+		if _, err := r.Get(ctx, userID); err != nil {
+			return err
+		}
+		return ErrCodeAlreadyUsed
+	}
+
+	return err
+}
+
+// UseRecoveryCode swaps the list only if nobody changed it since it was
+// read, retrying otherwise, so a code cannot be used twice concurrently.
+func (r *SQLTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID, hash string) error {
+	for {
+		twoFactor, err := r.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		i := slices.Index(twoFactor.RecoveryCodes, hash)
+		if i < 0 {
+			return ErrInvalidCode
+		}
+
+		current, err := encodeRecoveryCodes(twoFactor.RecoveryCodes)
+		if err != nil {
+			return err
+		}
+		next, err := encodeRecoveryCodes(slices.Delete(twoFactor.RecoveryCodes, i, i+1))
+		if err != nil {
+			return err
+		}
+
+		result, err := r.db.ExecContext(ctx,
+			r.db.Rebind("UPDATE two_factor SET recovery_codes = ?, failed_attempts = 0 "+
+				"WHERE user_id = ? AND recovery_codes = ?"),
+			next, userID, current,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to use recovery code: %w", err)
+		}
+
+		err = r.requireAffected(result, "failed to use recovery code")
+		if !errors.Is(err, ErrTwoFactorNotFound) {
+			return err
+		}
+	}
+}
+
+func (r *SQLTwoFactorRepository) SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error {
+	recoveryCodes, err := encodeRecoveryCodes(hashes)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE two_factor SET recovery_codes = ? WHERE user_id = ?"),
+		recoveryCodes, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set recovery codes: %w", err)
+	}
+
+	return r.requireAffected(result, "failed to set recovery codes")
+}
+
+func (r *SQLTwoFactorRepository) RecordFailure(
+	ctx context.Context,
+	userID string,
+	maxAttempts int,
+	lockedUntil time.Time,
+) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE two_factor SET "+
+			"locked_until = CASE WHEN failed_attempts + 1 >= ? THEN ? ELSE locked_until END, "+
+			"failed_attempts = CASE WHEN failed_attempts + 1 >= ? THEN 0 ELSE failed_attempts + 1 END "+
+			"WHERE user_id = ?"),
+		maxAttempts, lockedUntil.UTC(), maxAttempts, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record two-factor failure: %w", err)
+	}
+
+	return r.requireAffected(result, "failed to record two-factor failure")
+}
+
+func (r *SQLTwoFactorRepository) requireAffected(result sql.Result, message string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", message, err)
+	}
+
+	if affected == 0 {
+		return ErrTwoFactorNotFound
+	}
+
+	return nil
+}
+
+func encodeRecoveryCodes(hashes []string) (string, error) {
+	if hashes == nil {
+		hashes = []string{}
+	}
+
+	encoded, err := json.Marshal(hashes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode recovery codes: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+// nullTime converts an optional timestamp to a value the drivers store as
+// NULL when it is missing.
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
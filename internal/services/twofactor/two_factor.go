@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+
+	// totpSkew also accepts the code of the previous time step, for
+	// clocks running a little behind and codes typed at the last second.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+
+	maxFailedAttempts = 5
+	lockoutDuration   = 15 * time.Minute
+)
+
+// Status describes the second factor of the user, without its secrets.
+func (s *TwoFactorService) Status(ctx context.Context, user *models.User) (*TwoFactorStatus, error) {
+	status := &TwoFactorStatus{Required: s.authService.RequiresTwoFactor(user)}
+
+	twoFactor, err := s.repo.Get(ctx, user.ID)
+	if errors.Is(err, ErrTwoFactorNotFound) {
+		return status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status.Enabled = twoFactor.IsEnabled()
+	status.Pending = !twoFactor.IsEnabled()
+	status.EnabledAt = twoFactor.EnabledAt
+	status.RecoveryCodesLeft = len(twoFactor.RecoveryCodes)
+
+	return status, nil
+}
+
+// Enabled reports whether logging in as the user needs a second step.
+func (s *TwoFactorService) Enabled(ctx context.Context, userID string) (bool, error) {
+	twoFactor, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, ErrTwoFactorNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return twoFactor.IsEnabled(), nil
+}
+
+// Enroll generates a new secret for the user. It stays pending, and logins
+// keep working with the password alone, until Confirm proves the
+// authenticator app holds it. Enrolling again replaces a pending secret.
+func (s *TwoFactorService) Enroll(ctx context.Context, user *models.User) (*TwoFactorEnrollment, error) {
+	current, err := s.repo.Get(ctx, user.ID)
+	if err != nil && !errors.Is(err, ErrTwoFactorNotFound) {
+		return nil, err
+	}
+	if current != nil && current.IsEnabled() {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	twoFactor := models.TwoFactor{
+		UserID:    user.ID,
+		Secret:    util.GenerateTOTPSecret(),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.Save(ctx, twoFactor); err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorEnrollment{
+		Secret: twoFactor.Secret,
+		URI:    s.provisioningURI(user, twoFactor.Secret),
+	}, nil
+}
+
+// Confirm enables the pending second factor once the user types a code from
+// the authenticator app, and returns the recovery codes. They are shown only
+// this once. Every session of the user is revoked, so the next login goes
+// through the second step.
+func (s *TwoFactorService) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	twoFactor, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.IsEnabled() {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	if err := s.check(ctx, twoFactor, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := generateRecoveryCodes()
+	now := time.Now()
+
+	twoFactor, err = s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor.RecoveryCodes = hashes
+	twoFactor.EnabledAt = &now
+
+	if err := s.repo.Save(ctx, *twoFactor); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authService.LogoutAll(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable removes the second factor after checking a code from it. Users
+// whose roles require a second factor cannot turn it off.
+func (s *TwoFactorService) Disable(ctx context.Context, user *models.User, code string) error {
+	if s.authService.RequiresTwoFactor(user) {
+		return ErrTwoFactorRequired
+	}
+
+	twoFactor, err := s.enabled(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.check(ctx, twoFactor, code, true); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user after
+// checking a code, the old ones stop working.
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	twoFactor, err := s.enabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.check(ctx, twoFactor, code, true); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := generateRecoveryCodes()
+	if err := s.repo.SetRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Reset removes the second factor of a user who lost it, for admins. Every
+// session of the user is revoked.
+func (s *TwoFactorService) Reset(ctx context.Context, userID string) error {
+	if err := s.repo.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	_, err := s.authService.LogoutAll(ctx, userID)
+	return err
+}
+
+func (s *TwoFactorService) enabled(ctx context.Context, userID string) (*models.TwoFactor, error) {
+	twoFactor, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, ErrTwoFactorNotFound) {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !twoFactor.IsEnabled() {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	return twoFactor, nil
+}
+
+// check accepts a TOTP code not used before or, when allowed, one of the
+// recovery codes, which is then used up. Wrong codes count toward a lockout
+// so the code cannot be guessed.
+func (s *TwoFactorService) check(ctx context.Context, twoFactor *models.TwoFactor, code string, allowRecovery bool) error {
+	now := time.Now()
+	if twoFactor.IsLocked(now) {
+		return ErrTwoFactorLocked
+	}
+
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+
+	if counter, ok := util.MatchTOTP(twoFactor.Secret, code, now, totpPeriod, totpDigits, totpSkew); ok {
+		return s.repo.UseCounter(ctx, twoFactor.UserID, int64(counter))
+	}
+
+	if allowRecovery && len(code) != totpDigits {
+		err := s.repo.UseRecoveryCode(ctx, twoFactor.UserID, util.HashToken(normalizeRecoveryCode(code)))
+		if !errors.Is(err, ErrInvalidCode) {
+			return err
+		}
+	}
+
+	if err := s.repo.RecordFailure(ctx, twoFactor.UserID, maxFailedAttempts, now.Add(lockoutDuration)); err != nil {
+		return err
+	}
+
+	return ErrInvalidCode
+}
+
+func (s *TwoFactorService) provisioningURI(user *models.User, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", s.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(int(totpPeriod.Seconds())))
+
+	return "otpauth://totp/" + url.PathEscape(s.issuer+":"+user.Email) + "?" + query.Encode()
+}
+
+// generateRecoveryCodes returns the codes to show the user and the hashes to
+// store.
+func generateRecoveryCodes() ([]string, []string) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		codes[i] = util.GenerateCode(3)
+		hashes[i] = util.HashToken(normalizeRecoveryCode(codes[i]))
+	}
+
+	return codes, hashes
+}
+
+// normalizeRecoveryCode lets users type codes in any case and without the
+// dashes.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(code, "-", ""))
+}
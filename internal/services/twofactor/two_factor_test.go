@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const testUserID = "user-1"
+
+// newTestService returns a service with an enabled second factor for
+// testUserID and its secret.
+func newTestService(t *testing.T) (*TwoFactorService, string) {
+	t.Helper()
+
+	repo := NewMemoryTwoFactorRepository()
+	secret := util.GenerateTOTPSecret()
+	now := time.Now()
+
+	_, hashes := generateRecoveryCodes()
+	err := repo.Save(context.Background(), models.TwoFactor{
+		UserID:        testUserID,
+		Secret:        secret,
+		RecoveryCodes: hashes,
+		CreatedAt:     now,
+		EnabledAt:     &now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &TwoFactorService{repo: repo}, secret
+}
+
+// waitForFreshStep keeps a test from computing a code in one time step and
+// checking it in the next.
+func waitForFreshStep(t *testing.T) {
+	t.Helper()
+
+	elapsed := time.Duration(time.Now().UnixNano()) % totpPeriod
+	if elapsed > totpPeriod-2*time.Second {
+		time.Sleep(totpPeriod - elapsed)
+	}
+}
+
+// codeAt returns the code of the time step steps away from the current one.
+func codeAt(t *testing.T, secret string, steps int) string {
+	t.Helper()
+
+	counter := util.TOTPCounter(time.Now(), totpPeriod)
+	code, err := util.TOTP(secret, uint64(int64(counter)+int64(steps)), totpDigits)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return code
+}
+
+// checkCode loads the stored second factor and checks code against it, as
+// the login and disable flows do.
+func checkCode(t *testing.T, s *TwoFactorService, code string, allowRecovery bool) error {
+	t.Helper()
+
+	twoFactor, err := s.repo.Get(context.Background(), testUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s.check(context.Background(), twoFactor, code, allowRecovery)
+}
+
+func TestCheckSkew(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps int
+		code  func(code string) string
+		want  error
+	}{
+		{name: "current step", steps: 0},
+		{name: "previous step", steps: -1},
+		{name: "two steps back", steps: -2, want: ErrInvalidCode},
+		{name: "next step", steps: 1, want: ErrInvalidCode},
+		{
+			name:  "spaces are ignored",
+			steps: 0,
+			code:  func(code string) string { return " " + code[:3] + " " + code[3:] + " " },
+		},
+		{
+			name:  "wrong digit",
+			steps: 0,
+			code:  func(code string) string { return code[:5] + string('0'+(code[5]-'0'+1)%10) },
+			want:  ErrInvalidCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, secret := newTestService(t)
+			waitForFreshStep(t)
+
+			code := codeAt(t, secret, tt.steps)
+			if tt.code != nil {
+				code = tt.code(code)
+			}
+
+			if err := checkCode(t, s, code, false); !errors.Is(err, tt.want) {
+				t.Errorf("check(%q) = %v, want %v", code, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckReplay(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  int
+		second int
+		want   error
+	}{
+		{name: "same code twice", first: 0, second: 0, want: ErrCodeAlreadyUsed},
+		{name: "older code after newer", first: 0, second: -1, want: ErrCodeAlreadyUsed},
+		{name: "newer code after older", first: -1, second: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, secret := newTestService(t)
+			waitForFreshStep(t)
+
+			if err := checkCode(t, s, codeAt(t, secret, tt.first), false); err != nil {
+				t.Fatalf("first check = %v, want nil", err)
+			}
+
+			if err := checkCode(t, s, codeAt(t, secret, tt.second), false); !errors.Is(err, tt.want) {
+				t.Errorf("second check = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLockout(t *testing.T) {
+	s, secret := newTestService(t)
+
+	for i := 1; i <= maxFailedAttempts; i++ {
+		if err := checkCode(t, s, "000000x", false); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("wrong code %d = %v, want ErrInvalidCode", i, err)
+		}
+	}
+
+	waitForFreshStep(t)
+	if err := checkCode(t, s, codeAt(t, secret, 0), false); !errors.Is(err, ErrTwoFactorLocked) {
+		t.Fatalf("valid code while locked = %v, want ErrTwoFactorLocked", err)
+	}
+
+	twoFactor, err := s.repo.Get(context.Background(), testUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if twoFactor.LockedUntil == nil || twoFactor.LockedUntil.Before(time.Now().Add(lockoutDuration-time.Minute)) {
+		t.Fatalf("LockedUntil = %v, want about %s from now", twoFactor.LockedUntil, lockoutDuration)
+	}
+
+	expired := time.Now().Add(-time.Second)
+	twoFactor.LockedUntil = &expired
+	if err := s.repo.Save(context.Background(), *twoFactor); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForFreshStep(t)
+	if err := checkCode(t, s, codeAt(t, secret, 0), false); err != nil {
+		t.Fatalf("valid code after lockout = %v, want nil", err)
+	}
+}
+
+func TestCheckSuccessResetsFailures(t *testing.T) {
+	s, secret := newTestService(t)
+
+	for range maxFailedAttempts - 1 {
+		if err := checkCode(t, s, "000000x", false); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("wrong code = %v, want ErrInvalidCode", err)
+		}
+	}
+
+	waitForFreshStep(t)
+	if err := checkCode(t, s, codeAt(t, secret, 0), false); err != nil {
+		t.Fatalf("valid code = %v, want nil", err)
+	}
+
+	if err := checkCode(t, s, "000000x", false); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("wrong code = %v, want ErrInvalidCode", err)
+	}
+
+	twoFactor, err := s.repo.Get(context.Background(), testUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if twoFactor.LockedUntil != nil || twoFactor.FailedAttempts != 1 {
+		t.Errorf("after success and one failure LockedUntil = %v, FailedAttempts = %d, want nil and 1",
+			twoFactor.LockedUntil, twoFactor.FailedAttempts)
+	}
+}
+
+func TestCheckRecoveryCode(t *testing.T) {
+	s, _ := newTestService(t)
+
+	codes, hashes := generateRecoveryCodes()
+	if err := s.repo.SetRecoveryCodes(context.Background(), testUserID, hashes); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		code          string
+		allowRecovery bool
+		want          error
+	}{
+		{name: "not allowed", code: codes[0], allowRecovery: false, want: ErrInvalidCode},
+		{name: "allowed", code: codes[0], allowRecovery: true},
+		{name: "used twice", code: codes[0], allowRecovery: true, want: ErrInvalidCode},
+		{name: "lower case without dashes", code: strings.ToLower(strings.ReplaceAll(codes[1], "-", "")), allowRecovery: true},
+		{name: "unknown", code: "AAAA-AAAA-AAAA", allowRecovery: true, want: ErrInvalidCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkCode(t, s, tt.code, tt.allowRecovery); !errors.Is(err, tt.want) {
+				t.Errorf("check(%q) = %v, want %v", tt.code, err, tt.want)
+			}
+		})
+	}
+}
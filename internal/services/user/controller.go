@@ -3,13 +3,10 @@ package services
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"nitelog/internal/models"
-	"nitelog/internal/services"
 	"nitelog/internal/util"
 
-	"cloud.google.com/go/firestore"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,46 +15,57 @@ var (
 	ErrEmailTaken        = errors.New("email already taken")
 	ErrRegistrationTaken = errors.New("username already taken")
 	ErrNoChangesDetected = errors.New("no changes detected on update")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrInvalidSort       = errors.New("invalid sort field")
+	ErrUnknownRole       = errors.New("unknown role")
+	ErrRoleAssigned      = errors.New("role already assigned")
+	ErrRoleNotAssigned   = errors.New("role not assigned")
+	ErrLastAdmin         = errors.New("cannot revoke the role of the last admin")
 )
 
 type UserService struct {
-	collection *firestore.CollectionRef
+	repo UserRepository
 }
 
-func NewUserService() *UserService {
+func NewUserService(repo UserRepository) *UserService {
 	return &UserService{
-		collection: services.GetCollection("users"),
+		repo: repo,
 	}
 }
 
 func (s *UserService) isFieldTaken(ctx context.Context, field, value string, excludeID string) (bool, error) {
-	query := s.collection.
-		Where(field, "==", value).
-		Where("deletedAt", "==", nil).
-		Limit(1)
+	var (
+		user *models.User
+		err  error
+	)
 
-	if excludeID != "" {
-		docRef := s.collection.Doc(excludeID)
-		query = query.Where(firestore.DocumentID, "!=", docRef)
+	switch field {
+	case "email":
+		user, err = s.repo.GetByEmail(ctx, value)
+	case "registration":
+		user, err = s.repo.GetByRegistration(ctx, value)
+	default:
+		return false, errors.New("unknown unique field: " + field)
 	}
 
-	docs, err := query.Documents(ctx).GetAll()
+	if errors.Is(err, ErrUserNotFound) {
+		return false, nil
+	}
 	if err != nil {
-		return false, fmt.Errorf("firestore query failed: %w", err)
+		return false, err
 	}
-	return len(docs) > 0, nil
+	return user.ID != excludeID, nil
 }
 
-func GetAuthJWTWithUser(ginContext *gin.Context) (*models.User, error) {
+// GetAuthJWTWithUser loads the full profile of the caller, handlers that
+// only need the id or the roles should use the principal instead.
+func (s *UserService) GetAuthJWTWithUser(ginContext *gin.Context) (*models.User, error) {
 	userID, err := util.GetAuthJWT(ginContext)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx := context.Background()
-	userService := NewUserService()
-
-	user, err := userService.GetByID(ctx, userID)
+	user, err := s.GetByID(ginContext.Request.Context(), userID)
 	if err != nil {
 		return nil, err
 	}
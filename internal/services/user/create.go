@@ -34,17 +34,5 @@ func (s *UserService) Create(ctx context.Context, registration, email, name stri
 		CreatedAt:    time.Now(),
 	}
 
-	docRef, _, err := s.collection.Add(ctx, user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
-	}
-
-	user.ID = docRef.ID
-
-	doc, err := docRef.Get(ctx)
-	if err == nil {
-		doc.DataTo(&user)
-	}
-
-	return &user, nil
+	return s.repo.Create(ctx, user)
 }
@@ -0,0 +1,359 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"nitelog/internal/models"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FirestoreUserRepository struct {
+	collection *firestore.CollectionRef
+}
+
+func NewFirestoreUserRepository(client *firestore.Client) *FirestoreUserRepository {
+	return &FirestoreUserRepository{
+		collection: client.Collection("users"),
+	}
+}
+
+func (r *FirestoreUserRepository) Create(ctx context.Context, user models.User) (*models.User, error) {
+	docRef, _, err := r.collection.Add(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	user.ID = docRef.ID
+
+	doc, err := docRef.Get(ctx)
+	if err == nil {
+		doc.DataTo(&user)
+	}
+
+	return &user, nil
+}
+
+func (r *FirestoreUserRepository) GetByID(ctx context.Context, id string) (*models.User, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user, err := decodeUser(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (r *FirestoreUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.findOne(ctx, "email", email)
+}
+
+func (r *FirestoreUserRepository) GetByRegistration(ctx context.Context, registration string) (*models.User, error) {
+	return r.findOne(ctx, "registration", registration)
+}
+
+// List pages natively with Firestore cursors. Firestore has no case
+// insensitive prefix queries, so with a search the ordered results are
+// streamed and filtered here until the page is full.
+func (r *FirestoreUserRepository) List(ctx context.Context, query UserListQuery) ([]models.User, error) {
+	direction := firestore.Asc
+	if query.Descending {
+		direction = firestore.Desc
+	}
+
+	q := r.filter(query).
+		OrderBy(userSortField(query.Sort), direction).
+		OrderBy(firestore.DocumentID, direction)
+
+	if query.After != nil {
+		q = q.StartAfter(query.After.sortKey(), query.After.ID)
+	}
+
+	if query.Search == "" {
+		q = q.Limit(query.Limit)
+	}
+
+	usersIter := q.Documents(ctx)
+	defer usersIter.Stop()
+
+	users := make([]models.User, 0)
+	for len(users) < query.Limit {
+		doc, err := usersIter.Next()
+		if err == iterator.Done {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate users: %w", err)
+		}
+
+		user, err := decodeUser(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		if query.Search != "" && !matchesUserSearch(*user, query.Search) {
+			continue
+		}
+
+		users = append(users, *user)
+	}
+
+	return users, nil
+}
+
+func (r *FirestoreUserRepository) Count(ctx context.Context, query UserListQuery) (int, error) {
+	if query.Search != "" {
+		usersIter := r.filter(query).Documents(ctx)
+		defer usersIter.Stop()
+
+		total := 0
+		for {
+			doc, err := usersIter.Next()
+			if err == iterator.Done {
+				break
+			}
+
+			if err != nil {
+				return 0, fmt.Errorf("failed to iterate users: %w", err)
+			}
+
+			user, err := decodeUser(doc)
+			if err != nil {
+				return 0, err
+			}
+
+			if matchesUserSearch(*user, query.Search) {
+				total++
+			}
+		}
+
+		return total, nil
+	}
+
+	q := r.filter(query)
+	result, err := q.NewAggregationQuery().WithCount("total").Get(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	total, ok := result["total"].(*firestorepb.Value)
+	if !ok {
+		return 0, fmt.Errorf("failed to count users: unexpected result %v", result["total"])
+	}
+
+	return int(total.GetIntegerValue()), nil
+}
+
+func (r *FirestoreUserRepository) Update(ctx context.Context, id string, update UserUpdate) error {
+	var updates []firestore.Update
+
+	if update.Registration != nil {
+		updates = append(updates, firestore.Update{Path: "registration", Value: *update.Registration})
+	}
+
+	if update.Email != nil {
+		updates = append(updates, firestore.Update{Path: "email", Value: *update.Email})
+	}
+
+	if update.Name != nil {
+		updates = append(updates, firestore.Update{Path: "name", Value: *update.Name})
+	}
+
+	if update.PasswordHash != nil {
+		updates = append(updates, firestore.Update{Path: "passwordHash", Value: *update.PasswordHash})
+	}
+
+	if update.Roles != nil {
+		updates = append(updates, firestore.Update{Path: "roles", Value: update.Roles})
+	}
+
+	if update.BumpTokenVersion {
+		updates = append(updates, firestore.Update{Path: "tokenVersion", Value: firestore.Increment(1)})
+	}
+
+	if update.EmailVerified != nil {
+		var emailVerifiedAt any
+		if *update.EmailVerified {
+			emailVerifiedAt = firestore.ServerTimestamp
+		}
+		updates = append(updates, firestore.Update{Path: "emailVerifiedAt", Value: emailVerifiedAt})
+	}
+
+	updates = append(updates, firestore.Update{
+		Path:  "updatedAt",
+		Value: firestore.ServerTimestamp,
+	})
+
+	_, err := r.collection.Doc(id).Update(ctx, updates)
+	if status.Code(err) == codes.NotFound {
+		return ErrUserNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreUserRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "deletedAt",
+			Value: firestore.ServerTimestamp,
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrUserNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreUserRepository) ListDeleted(ctx context.Context) ([]models.User, error) {
+	docs, err := r.collection.
+		Where("deletedAt", "!=", nil).
+		OrderBy("deletedAt", firestore.Desc).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted users: %w", err)
+	}
+
+	users := make([]models.User, 0, len(docs))
+	for _, doc := range docs {
+		user, err := decodeUser(doc)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, nil
+}
+
+func (r *FirestoreUserRepository) GetDeletedByID(ctx context.Context, id string) (*models.User, error) {
+	doc, err := r.collection.Doc(id).Get(ctx)
+
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user, err := decodeUser(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DeletedAt == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (r *FirestoreUserRepository) Restore(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{
+			Path:  "deletedAt",
+			Value: nil,
+		},
+		{
+			Path:  "updatedAt",
+			Value: firestore.ServerTimestamp,
+		},
+	})
+
+	if status.Code(err) == codes.NotFound {
+		return ErrUserNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreUserRepository) HardDelete(ctx context.Context, id string) error {
+	_, err := r.collection.Doc(id).Delete(ctx)
+	if status.Code(err) == codes.NotFound {
+		return ErrUserNotFound
+	}
+
+	return err
+}
+
+func (r *FirestoreUserRepository) findOne(ctx context.Context, field, value string) (*models.User, error) {
+	query := r.collection.
+		Where(field, "==", value).
+		Where("deletedAt", "==", nil).
+		Limit(1)
+
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	if len(docs) == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	return decodeUser(docs[0])
+}
+
+func (r *FirestoreUserRepository) filter(query UserListQuery) firestore.Query {
+	q := r.collection.Where("deletedAt", "==", nil)
+
+	if query.Role != "" {
+		q = q.Where("roles", "array-contains", query.Role)
+	}
+
+	return q
+}
+
+func userSortField(sort string) string {
+	switch sort {
+	case UserSortRegistration:
+		return "registration"
+	case UserSortCreatedAt:
+		return "createdAt"
+	default:
+		return "name"
+	}
+}
+
+func decodeUser(doc *firestore.DocumentSnapshot) (*models.User, error) {
+	var user models.User
+	if err := doc.DataTo(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode user %s: %w", doc.Ref.ID, err)
+	}
+
+	user.ID = doc.Ref.ID
+
+	// Users created before email verification existed have no such field
+	// at all, new users store null until they verify.
+	if _, ok := doc.Data()["emailVerifiedAt"]; !ok {
+		createdAt := user.CreatedAt
+		user.EmailVerifiedAt = &createdAt
+	}
+
+	return &user, nil
+}
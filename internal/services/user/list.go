@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"nitelog/internal/models"
+)
+
+const (
+	DefaultUserPageSize = 50
+	MaxUserPageSize     = 200
+)
+
+type UserListOptions struct {
+	Sort       string
+	Descending bool
+	Role       string
+	Search     string
+	Limit      int
+	Cursor     string
+}
+
+// @model UserPage
+type UserPage struct {
+	Users      []models.User `json:"users"`
+	NextCursor string        `json:"next_cursor,omitempty" example:"eyJzIjoibmFtZSIsInYiOiJKb2huIiwiaSI6ImQ0ZTVmNiJ9"`
+	Total      int           `json:"total" example:"312"`
+}
+
+// List returns a page of users and the number of users matching the
+// filters. The cursor is only valid for the sort it was issued with.
+func (s *UserService) List(ctx context.Context, opts UserListOptions) (*UserPage, error) {
+	sort := opts.Sort
+	switch sort {
+	case "":
+		sort = UserSortName
+	case UserSortName, UserSortRegistration, UserSortCreatedAt:
+	default:
+		return nil, ErrInvalidSort
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultUserPageSize
+	}
+	limit = min(limit, MaxUserPageSize)
+
+	query := UserListQuery{
+		Sort:       sort,
+		Descending: opts.Descending,
+		Role:       opts.Role,
+		Search:     opts.Search,
+		Limit:      limit + 1,
+	}
+
+	if opts.Cursor != "" {
+		cursor, err := decodeUserCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor.Sort != sort || cursor.Descending != opts.Descending {
+			return nil, ErrInvalidCursor
+		}
+		query.After = cursor
+	}
+
+	users, err := s.repo.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.repo.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &UserPage{Users: users, Total: total}
+	if len(users) > limit {
+		page.Users = users[:limit]
+		page.NextCursor = encodeUserCursor(newUserCursor(page.Users[limit-1], sort, opts.Descending))
+	}
+
+	return page, nil
+}
+
+func encodeUserCursor(cursor UserCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(value string) (*UserCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor UserCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
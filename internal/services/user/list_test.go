@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	repos := map[string]func(t *testing.T) UserRepository{
+		"memory": func(t *testing.T) UserRepository { return NewMemoryUserRepository() },
+		"sqlite": func(t *testing.T) UserRepository { return newTestSQLRepository(t) },
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			testList(t, NewUserService(repo), repo)
+		})
+	}
+}
+
+func testList(t *testing.T, service *UserService, repo UserRepository) {
+	ctx := context.Background()
+
+	for _, user := range []struct{ registration, email, name string }{
+		{"3", "carla@example.com", "Carla"},
+		{"1", "ana@example.com", "Ana"},
+		{"4", "bruno@other.org", "Bruno"},
+		{"2", "anibal@example.com", "Aníbal"},
+		{"5", "deleted@example.com", "Deleted"},
+	} {
+		created, err := service.Create(ctx, user.registration, user.email, user.name, []byte("hash"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch user.name {
+		case "Carla":
+			if err := repo.Update(ctx, created.ID, UserUpdate{Roles: []string{"admin"}}); err != nil {
+				t.Fatal(err)
+			}
+		case "Deleted":
+			if err := service.SoftDelete(ctx, created.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	list := func(opts UserListOptions) ([]string, int) {
+		t.Helper()
+
+		var names []string
+		for {
+			page, err := service.List(ctx, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, user := range page.Users {
+				names = append(names, user.Name)
+			}
+			if page.NextCursor == "" {
+				return names, page.Total
+			}
+			opts.Cursor = page.NextCursor
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts UserListOptions
+		want []string
+	}{
+		{"by name", UserListOptions{Limit: 2}, []string{"Ana", "Aníbal", "Bruno", "Carla"}},
+		{"by registration descending", UserListOptions{Sort: UserSortRegistration, Descending: true, Limit: 3}, []string{"Bruno", "Carla", "Aníbal", "Ana"}},
+		{"search", UserListOptions{Search: "AN", Limit: 1}, []string{"Ana", "Aníbal"}},
+		{"search by email", UserListOptions{Search: "bruno@"}, []string{"Bruno"}},
+		{"role", UserListOptions{Role: "admin"}, []string{"Carla"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total := list(tt.opts)
+			if !slices.Equal(got, tt.want) || total != len(tt.want) {
+				t.Errorf("List() = %v (total %d), want %v", got, total, tt.want)
+			}
+		})
+	}
+
+	page, err := service.List(ctx, UserListOptions{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.List(ctx, UserListOptions{Sort: UserSortRegistration, Cursor: page.NextCursor})
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("List() with the cursor of another sort = %v, want ErrInvalidCursor", err)
+	}
+
+	if _, err := service.List(ctx, UserListOptions{Sort: "email"}); !errors.Is(err, ErrInvalidSort) {
+		t.Errorf("List() with an unknown sort = %v, want ErrInvalidSort", err)
+	}
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+// MemoryUserRepository keeps users in process memory. It is meant for local
+// development and tests, all data is lost when the server stops.
+type MemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]models.User
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{
+		users: make(map[string]models.User),
+	}
+}
+
+func (r *MemoryUserRepository) Create(ctx context.Context, user models.User) (*models.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, found := r.find(func(u models.User) bool { return u.Email == user.Email }); found {
+		return nil, ErrEmailTaken
+	}
+
+	if _, found := r.find(func(u models.User) bool { return u.Registration == user.Registration }); found {
+		return nil, ErrRegistrationTaken
+	}
+
+	user.ID = util.GenerateID()
+	user.DeletedAt = nil
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = time.Now()
+	}
+	r.users[user.ID] = user
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetByID(ctx context.Context, id string) (*models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, found := r.find(func(u models.User) bool { return u.Email == email })
+	if !found {
+		return nil, ErrUserNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) GetByRegistration(ctx context.Context, registration string) (*models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, found := r.find(func(u models.User) bool { return u.Registration == registration })
+	if !found {
+		return nil, ErrUserNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) List(ctx context.Context, query UserListQuery) ([]models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := r.filter(query)
+
+	slices.SortFunc(users, func(a, b models.User) int {
+		return compareUserPosition(a, newUserCursor(b, query.Sort, query.Descending))
+	})
+
+	if query.After != nil {
+		users = slices.DeleteFunc(users, func(u models.User) bool {
+			return compareUserPosition(u, *query.After) <= 0
+		})
+	}
+
+	if len(users) > query.Limit {
+		users = users[:query.Limit]
+	}
+
+	for i := range users {
+		users[i] = *copyUser(users[i])
+	}
+
+	return users, nil
+}
+
+func (r *MemoryUserRepository) Count(ctx context.Context, query UserListQuery) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.filter(query)), nil
+}
+
+func (r *MemoryUserRepository) Update(ctx context.Context, id string, update UserUpdate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	if update.Registration != nil {
+		other, found := r.find(func(u models.User) bool { return u.Registration == *update.Registration })
+		if found && other.ID != id {
+			return ErrRegistrationTaken
+		}
+		user.Registration = *update.Registration
+	}
+
+	if update.Email != nil {
+		other, found := r.find(func(u models.User) bool { return u.Email == *update.Email })
+		if found && other.ID != id {
+			return ErrEmailTaken
+		}
+		user.Email = *update.Email
+	}
+
+	if update.Name != nil {
+		user.Name = *update.Name
+	}
+
+	if update.PasswordHash != nil {
+		user.PasswordHash = *update.PasswordHash
+	}
+
+	if update.Roles != nil {
+		user.Roles = slices.Clone(update.Roles)
+	}
+
+	if update.BumpTokenVersion {
+		user.TokenVersion++
+	}
+
+	if update.EmailVerified != nil {
+		user.EmailVerifiedAt = nil
+		if *update.EmailVerified {
+			now := time.Now()
+			user.EmailVerifiedAt = &now
+		}
+	}
+
+	user.UpdatedAt = time.Now()
+	r.users[id] = user
+
+	return nil
+}
+
+func (r *MemoryUserRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	now := time.Now()
+	user.DeletedAt = &now
+	user.UpdatedAt = now
+	r.users[id] = user
+
+	return nil
+}
+
+func (r *MemoryUserRepository) ListDeleted(ctx context.Context) ([]models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]models.User, 0)
+	for _, user := range r.users {
+		if user.DeletedAt != nil {
+			users = append(users, *copyUser(user))
+		}
+	}
+
+	slices.SortFunc(users, func(a, b models.User) int {
+		return b.DeletedAt.Compare(*a.DeletedAt)
+	})
+
+	return users, nil
+}
+
+func (r *MemoryUserRepository) GetDeletedByID(ctx context.Context, id string) (*models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+func (r *MemoryUserRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt == nil {
+		return ErrUserNotFound
+	}
+
+	if _, found := r.find(func(u models.User) bool { return u.Email == user.Email }); found {
+		return ErrEmailTaken
+	}
+
+	if _, found := r.find(func(u models.User) bool { return u.Registration == user.Registration }); found {
+		return ErrRegistrationTaken
+	}
+
+	user.DeletedAt = nil
+	user.UpdatedAt = time.Now()
+	r.users[id] = user
+
+	return nil
+}
+
+func (r *MemoryUserRepository) HardDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return ErrUserNotFound
+	}
+
+	delete(r.users, id)
+
+	return nil
+}
+
+// find returns the first non deleted user matching the predicate, callers
+// must hold the lock.
+func (r *MemoryUserRepository) find(match func(models.User) bool) (models.User, bool) {
+	for _, user := range r.users {
+		if user.DeletedAt == nil && match(user) {
+			return user, true
+		}
+	}
+	return models.User{}, false
+}
+
+// filter returns the non deleted users matching the role and search of the
+// query, callers must hold the lock.
+func (r *MemoryUserRepository) filter(query UserListQuery) []models.User {
+	users := make([]models.User, 0, len(r.users))
+	for _, user := range r.users {
+		if user.DeletedAt != nil {
+			continue
+		}
+
+		if query.Role != "" && !slices.Contains(user.Roles, query.Role) {
+			continue
+		}
+
+		if query.Search != "" && !matchesUserSearch(user, query.Search) {
+			continue
+		}
+
+		users = append(users, user)
+	}
+
+	return users
+}
+
+// compareUserPosition orders users the same way List pages through them,
+// by the cursor sort key and then by id, reversed for descending sorts.
+func compareUserPosition(user models.User, cursor UserCursor) int {
+	position := newUserCursor(user, cursor.Sort, cursor.Descending)
+
+	c := strings.Compare(position.Value, cursor.Value)
+	if cursor.Sort == UserSortCreatedAt {
+		c = position.CreatedAt.Compare(cursor.CreatedAt)
+	}
+	if c == 0 {
+		c = strings.Compare(position.ID, cursor.ID)
+	}
+
+	if cursor.Descending {
+		return -c
+	}
+	return c
+}
+
+func copyUser(user models.User) *models.User {
+	user.Roles = slices.Clone(user.Roles)
+	return &user
+}
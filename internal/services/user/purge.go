@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// ListDeletedBefore returns the users soft deleted before the cutoff.
+func (s *UserService) ListDeletedBefore(ctx context.Context, before time.Time) ([]models.User, error) {
+	users, err := s.repo.ListDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expired := make([]models.User, 0, len(users))
+	for _, user := range users {
+		if user.DeletedAt.Before(before) {
+			expired = append(expired, user)
+		}
+	}
+
+	return expired, nil
+}
+
+func (s *UserService) HardDelete(ctx context.Context, id string) error {
+	return s.repo.HardDelete(ctx, id)
+}
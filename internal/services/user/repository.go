@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"nitelog/internal/models"
+)
+
+// UserRepository is the storage backend used by UserService.
+// Implementations must ignore soft deleted users on every lookup and return
+// ErrUserNotFound when nothing matches. Backends able to enforce uniqueness
+// atomically report conflicts with ErrEmailTaken and ErrRegistrationTaken
+// themselves.
+type UserRepository interface {
+	Create(ctx context.Context, user models.User) (*models.User, error)
+	GetByID(ctx context.Context, id string) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByRegistration(ctx context.Context, registration string) (*models.User, error)
+
+	// List returns up to query.Limit users ordered by query.Sort and then id,
+	// starting right after query.After when it is set. Count ignores the
+	// cursor and the limit.
+	List(ctx context.Context, query UserListQuery) ([]models.User, error)
+	Count(ctx context.Context, query UserListQuery) (int, error)
+
+	Update(ctx context.Context, id string, update UserUpdate) error
+	SoftDelete(ctx context.Context, id string) error
+
+	// ListDeleted, GetDeletedByID and Restore only see soft deleted users.
+	ListDeleted(ctx context.Context) ([]models.User, error)
+	GetDeletedByID(ctx context.Context, id string) (*models.User, error)
+	Restore(ctx context.Context, id string) error
+	HardDelete(ctx context.Context, id string) error
+}
+
+// UserUpdate holds the fields to be changed on a user, nil fields are left
+// untouched.
+type UserUpdate struct {
+	Registration *string
+	Email        *string
+	Name         *string
+	PasswordHash *string
+	Roles        []string
+
+	// BumpTokenVersion increments the token version, refresh tokens issued
+	// for an older version stop working.
+	BumpTokenVersion bool
+
+	// EmailVerified marks the email as verified now when true and as not
+	// verified when false.
+	EmailVerified *bool
+}
+
+const (
+	UserSortName         = "name"
+	UserSortRegistration = "registration"
+	UserSortCreatedAt    = "created_at"
+)
+
+// UserListQuery filters a List call. Search is a case insensitive prefix
+// matched against name, email and registration.
+type UserListQuery struct {
+	Sort       string
+	Descending bool
+	Role       string
+	Search     string
+	After      *UserCursor
+	Limit      int
+}
+
+// UserCursor is the position of the last user of a page. Value holds the
+// sort key for text sorts and CreatedAt the one for UserSortCreatedAt.
+type UserCursor struct {
+	Sort       string    `json:"s"`
+	Descending bool      `json:"o,omitempty"`
+	Value      string    `json:"v,omitempty"`
+	CreatedAt  time.Time `json:"t,omitempty"`
+	ID         string    `json:"i"`
+}
+
+func newUserCursor(user models.User, sort string, descending bool) UserCursor {
+	cursor := UserCursor{Sort: sort, Descending: descending, ID: user.ID}
+
+	switch sort {
+	case UserSortRegistration:
+		cursor.Value = user.Registration
+	case UserSortCreatedAt:
+		cursor.CreatedAt = user.CreatedAt
+	default:
+		cursor.Value = user.Name
+	}
+
+	return cursor
+}
+
+// sortKey returns the cursor position in the type the backends compare.
+func (c *UserCursor) sortKey() any {
+	if c.Sort == UserSortCreatedAt {
+		return c.CreatedAt
+	}
+	return c.Value
+}
+
+func matchesUserSearch(user models.User, search string) bool {
+	search = strings.ToLower(search)
+
+	return strings.HasPrefix(strings.ToLower(user.Name), search) ||
+		strings.HasPrefix(strings.ToLower(user.Email), search) ||
+		strings.HasPrefix(strings.ToLower(user.Registration), search)
+}
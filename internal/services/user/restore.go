@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"nitelog/internal/models"
+)
+
+func (s *UserService) ListDeleted(ctx context.Context) ([]models.User, error) {
+	return s.repo.ListDeleted(ctx)
+}
+
+// Restore undoes a soft delete. When the email or the registration of the
+// user has been reused since it was deleted, every conflict is returned
+// joined so callers can report all of them with errors.Is.
+func (s *UserService) Restore(ctx context.Context, id string) (*models.User, error) {
+	user, err := s.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []error
+
+	emailTaken, err := s.isFieldTaken(ctx, "email", user.Email, id)
+	if err != nil {
+		return nil, fmt.Errorf("email check failed: %w", err)
+	}
+	if emailTaken {
+		conflicts = append(conflicts, ErrEmailTaken)
+	}
+
+	registrationTaken, err := s.isFieldTaken(ctx, "registration", user.Registration, id)
+	if err != nil {
+		return nil, fmt.Errorf("registration check failed: %w", err)
+	}
+	if registrationTaken {
+		conflicts = append(conflicts, ErrRegistrationTaken)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, errors.Join(conflicts...)
+	}
+
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRestoreConflicts(t *testing.T) {
+	service := NewUserService(NewMemoryUserRepository())
+	ctx := context.Background()
+
+	deleted, err := service.Create(ctx, "1", "a@example.com", "Ana", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.SoftDelete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := service.Create(ctx, "1", "a@example.com", "Bia", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = service.Restore(ctx, deleted.ID)
+	if !errors.Is(err, ErrEmailTaken) || !errors.Is(err, ErrRegistrationTaken) {
+		t.Errorf("Restore() = %v, want both ErrEmailTaken and ErrRegistrationTaken", err)
+	}
+
+	if err := service.SoftDelete(ctx, other.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Restore(ctx, deleted.ID); err != nil {
+		t.Errorf("Restore() once the email and registration are free = %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"nitelog/internal/models"
+)
+
+// AssignRole adds role to the user and bumps its token version, so refresh
+// tokens carrying the old roles stop working.
+func (s *UserService) AssignRole(ctx context.Context, id, role string) (*models.User, error) {
+	if !models.IsKnownRole(role) {
+		return nil, ErrUnknownRole
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(user.Roles, role) {
+		return nil, ErrRoleAssigned
+	}
+
+	return s.setRoles(ctx, id, append(slices.Clone(user.Roles), role))
+}
+
+// RevokeRole removes role from the user. The admin role of the only admin
+// left cannot be revoked.
+func (s *UserService) RevokeRole(ctx context.Context, id, role string) (*models.User, error) {
+	if !models.IsKnownRole(role) {
+		return nil, ErrUnknownRole
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(user.Roles, role) {
+		return nil, ErrRoleNotAssigned
+	}
+
+	if role == models.RoleAdmin {
+		admins, err := s.repo.Count(ctx, UserListQuery{Role: models.RoleAdmin})
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, ErrLastAdmin
+		}
+	}
+
+	roles := slices.DeleteFunc(slices.Clone(user.Roles), func(r string) bool {
+		return r == role
+	})
+
+	return s.setRoles(ctx, id, roles)
+}
+
+func (s *UserService) setRoles(ctx context.Context, id string, roles []string) (*models.User, error) {
+	update := UserUpdate{
+		Roles:            roles,
+		BumpTokenVersion: true,
+	}
+
+	if err := s.repo.Update(ctx, id, update); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, id)
+}
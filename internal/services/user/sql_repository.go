@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+	"nitelog/internal/util"
+)
+
+const userColumns = "id, registration, name, email, password_hash, roles, token_version, email_verified_at, created_at, " +
+	"updated_at, deleted_at"
+
+type SQLUserRepository struct {
+	db *database.DB
+}
+
+func NewSQLUserRepository(db *database.DB) *SQLUserRepository {
+	return &SQLUserRepository{db: db}
+}
+
+func (r *SQLUserRepository) Create(ctx context.Context, user models.User) (*models.User, error) {
+	if user.Roles == nil {
+		user.Roles = []string{}
+	}
+
+	roles, err := json.Marshal(user.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode roles: %w", err)
+	}
+
+	user.ID = util.GenerateID()
+	user.CreatedAt = user.CreatedAt.UTC()
+	user.DeletedAt = nil
+
+	var emailVerifiedAt sql.NullTime
+	if user.EmailVerifiedAt != nil {
+		emailVerifiedAt = sql.NullTime{Time: user.EmailVerifiedAt.UTC(), Valid: true}
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		r.db.Rebind("INSERT INTO users ("+userColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NULL, NULL)"),
+		user.ID, user.Registration, user.Name, user.Email, user.PasswordHash, string(roles), user.TokenVersion,
+		emailVerifiedAt, user.CreatedAt,
+	)
+	if err != nil {
+		return nil, userConstraintError(err, "failed to create user")
+	}
+
+	return &user, nil
+}
+
+func (r *SQLUserRepository) GetByID(ctx context.Context, id string) (*models.User, error) {
+	return r.findOne(ctx, "id = ? AND deleted_at IS NULL", id)
+}
+
+func (r *SQLUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.findOne(ctx, "email = ? AND deleted_at IS NULL", email)
+}
+
+func (r *SQLUserRepository) GetByRegistration(ctx context.Context, registration string) (*models.User, error) {
+	return r.findOne(ctx, "registration = ? AND deleted_at IS NULL", registration)
+}
+
+func (r *SQLUserRepository) List(ctx context.Context, query UserListQuery) ([]models.User, error) {
+	conditions, args := userListConditions(query)
+
+	column := userSortColumn(query.Sort)
+	direction, comparison := "ASC", ">"
+	if query.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if query.After != nil {
+		key := query.After.sortKey()
+		if t, ok := key.(time.Time); ok {
+			key = t.UTC()
+		}
+
+		conditions = append(conditions,
+			"("+column+" "+comparison+" ? OR ("+column+" = ? AND id "+comparison+" ?))",
+		)
+		args = append(args, key, key, query.After.ID)
+	}
+
+	return r.queryUsers(ctx,
+		"SELECT "+userColumns+" FROM users WHERE "+strings.Join(conditions, " AND ")+
+			" ORDER BY "+column+" "+direction+", id "+direction+" LIMIT ?",
+		append(args, query.Limit)...,
+	)
+}
+
+func (r *SQLUserRepository) Count(ctx context.Context, query UserListQuery) (int, error) {
+	conditions, args := userListConditions(query)
+
+	var total int
+	err := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT COUNT(*) FROM users WHERE "+strings.Join(conditions, " AND ")),
+		args...,
+	).Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *SQLUserRepository) Update(ctx context.Context, id string, update UserUpdate) error {
+	sets := []string{"updated_at = ?"}
+	args := []any{time.Now().UTC()}
+
+	if update.Registration != nil {
+		sets = append(sets, "registration = ?")
+		args = append(args, *update.Registration)
+	}
+
+	if update.Email != nil {
+		sets = append(sets, "email = ?")
+		args = append(args, *update.Email)
+	}
+
+	if update.Name != nil {
+		sets = append(sets, "name = ?")
+		args = append(args, *update.Name)
+	}
+
+	if update.PasswordHash != nil {
+		sets = append(sets, "password_hash = ?")
+		args = append(args, *update.PasswordHash)
+	}
+
+	if update.Roles != nil {
+		roles, err := json.Marshal(update.Roles)
+		if err != nil {
+			return fmt.Errorf("failed to encode roles: %w", err)
+		}
+		sets = append(sets, "roles = ?")
+		args = append(args, string(roles))
+	}
+
+	if update.BumpTokenVersion {
+		sets = append(sets, "token_version = token_version + 1")
+	}
+
+	if update.EmailVerified != nil {
+		var emailVerifiedAt sql.NullTime
+		if *update.EmailVerified {
+			emailVerifiedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+		}
+		sets = append(sets, "email_verified_at = ?")
+		args = append(args, emailVerifiedAt)
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE users SET "+strings.Join(sets, ", ")+" WHERE id = ?"),
+		append(args, id)...,
+	)
+	if err != nil {
+		return userConstraintError(err, "failed to update user")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLUserRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now().UTC()
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE users SET deleted_at = ?, updated_at = ? WHERE id = ?"),
+		now, now, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLUserRepository) ListDeleted(ctx context.Context) ([]models.User, error) {
+	return r.queryUsers(ctx,
+		"SELECT "+userColumns+" FROM users WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC",
+	)
+}
+
+func (r *SQLUserRepository) GetDeletedByID(ctx context.Context, id string) (*models.User, error) {
+	return r.findOne(ctx, "id = ? AND deleted_at IS NOT NULL", id)
+}
+
+func (r *SQLUserRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		r.db.Rebind("UPDATE users SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL"),
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return userConstraintError(err, "failed to restore user")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLUserRepository) HardDelete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, r.db.Rebind("DELETE FROM users WHERE id = ?"), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLUserRepository) queryUsers(ctx context.Context, query string, args ...any) ([]models.User, error) {
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]models.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *SQLUserRepository) findOne(ctx context.Context, condition string, arg any) (*models.User, error) {
+	row := r.db.QueryRowContext(ctx,
+		r.db.Rebind("SELECT "+userColumns+" FROM users WHERE "+condition+" LIMIT 1"),
+		arg,
+	)
+
+	user, err := scanUser(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	return user, nil
+}
+
+func userListConditions(query UserListQuery) ([]string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	args := []any{}
+
+	if query.Role != "" {
+		role, _ := json.Marshal(query.Role)
+		conditions = append(conditions, `roles LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(string(role))+"%")
+	}
+
+	if query.Search != "" {
+		prefix := escapeLike(strings.ToLower(query.Search)) + "%"
+		conditions = append(conditions,
+			`(LOWER(name) LIKE ? ESCAPE '\' OR LOWER(email) LIKE ? ESCAPE '\' OR LOWER(registration) LIKE ? ESCAPE '\')`,
+		)
+		args = append(args, prefix, prefix, prefix)
+	}
+
+	return conditions, args
+}
+
+func userSortColumn(sort string) string {
+	switch sort {
+	case UserSortRegistration:
+		return "registration"
+	case UserSortCreatedAt:
+		return "created_at"
+	default:
+		return "name"
+	}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (*models.User, error) {
+	var (
+		user            models.User
+		roles           string
+		emailVerifiedAt sql.NullTime
+		updatedAt       sql.NullTime
+		deletedAt       sql.NullTime
+	)
+
+	err := row.Scan(
+		&user.ID,
+		&user.Registration,
+		&user.Name,
+		&user.Email,
+		&user.PasswordHash,
+		&roles,
+		&user.TokenVersion,
+		&emailVerifiedAt,
+		&user.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(roles), &user.Roles); err != nil {
+		return nil, fmt.Errorf("failed to decode roles of user %s: %w", user.ID, err)
+	}
+
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
+
+	if updatedAt.Valid {
+		user.UpdatedAt = updatedAt.Time
+	}
+
+	if deletedAt.Valid {
+		user.DeletedAt = &deletedAt.Time
+	}
+
+	return &user, nil
+}
+
+func userConstraintError(err error, message string) error {
+	switch {
+	case database.IsUniqueViolation(err, "users", "email"):
+		return ErrEmailTaken
+	case database.IsUniqueViolation(err, "users", "registration"):
+		return ErrRegistrationTaken
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"nitelog/internal/database"
+	"nitelog/internal/models"
+)
+
+func newTestSQLRepository(t *testing.T) *SQLUserRepository {
+	t.Helper()
+
+	db, err := database.Open(database.DialectSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(context.Background(), db); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSQLUserRepository(db)
+}
+
+func TestSQLUserRepositoryRoundTrip(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, models.User{
+		Registration: "123",
+		Name:         "Ana",
+		Email:        "ana@example.com",
+		PasswordHash: "hash",
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, get := range map[string]func() (*models.User, error){
+		"id":           func() (*models.User, error) { return repo.GetByID(ctx, created.ID) },
+		"email":        func() (*models.User, error) { return repo.GetByEmail(ctx, "ana@example.com") },
+		"registration": func() (*models.User, error) { return repo.GetByRegistration(ctx, "123") },
+	} {
+		user, err := get()
+		if err != nil {
+			t.Fatalf("get by %s: %v", name, err)
+		}
+		if user.ID != created.ID || user.Name != "Ana" || user.PasswordHash != "hash" || user.Roles == nil {
+			t.Errorf("get by %s = %+v, want the created user with empty roles", name, user)
+		}
+	}
+
+	name, hash := "Ana Maria", "new-hash"
+	err = repo.Update(ctx, created.ID, UserUpdate{Name: &name, PasswordHash: &hash, Roles: []string{"admin"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Name != name || user.PasswordHash != hash || !slices.Equal(user.Roles, []string{"admin"}) || user.UpdatedAt.IsZero() {
+		t.Errorf("after update got %+v", user)
+	}
+}
+
+func TestSQLUserRepositoryUniqueness(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+
+	first, err := repo.Create(ctx, models.User{Registration: "1", Email: "a@example.com", CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		user models.User
+		want error
+	}{
+		{"email taken", models.User{Registration: "2", Email: "a@example.com"}, ErrEmailTaken},
+		{"registration taken", models.User{Registration: "1", Email: "b@example.com"}, ErrRegistrationTaken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := repo.Create(ctx, tt.user); !errors.Is(err, tt.want) {
+				t.Errorf("Create() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if err := repo.SoftDelete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.GetByEmail(ctx, "a@example.com"); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("GetByEmail of deleted user = %v, want ErrUserNotFound", err)
+	}
+
+	if _, err := repo.Create(ctx, models.User{Registration: "1", Email: "a@example.com"}); err != nil {
+		t.Errorf("reusing the email and registration of a deleted user: %v", err)
+	}
+}
+
+func TestSQLUserRepositoryMissing(t *testing.T) {
+	repo := newTestSQLRepository(t)
+	ctx := context.Background()
+	name := "x"
+
+	if err := repo.Update(ctx, "missing", UserUpdate{Name: &name}); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Update() = %v, want ErrUserNotFound", err)
+	}
+
+	if err := repo.SoftDelete(ctx, "missing"); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("SoftDelete() = %v, want ErrUserNotFound", err)
+	}
+}
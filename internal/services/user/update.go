@@ -6,8 +6,6 @@ import (
 
 	"nitelog/internal/models"
 	"nitelog/internal/util"
-
-	"cloud.google.com/go/firestore"
 )
 
 func (s *UserService) Update(ctx context.Context, id string, updatedUser models.User) error {
@@ -16,7 +14,7 @@ func (s *UserService) Update(ctx context.Context, id string, updatedUser models.
 		return err
 	}
 
-	var updates []firestore.Update
+	var update UserUpdate
 	changes := false
 
 	if updatedUser.Registration != "" && updatedUser.Registration != existingUser.Registration {
@@ -29,10 +27,7 @@ func (s *UserService) Update(ctx context.Context, id string, updatedUser models.
 			return ErrRegistrationTaken
 		}
 
-		updates = append(updates, firestore.Update{
-			Path:  "registration",
-			Value: updatedUser.Registration,
-		})
+		update.Registration = &updatedUser.Registration
 		changes = true
 	}
 
@@ -44,18 +39,14 @@ func (s *UserService) Update(ctx context.Context, id string, updatedUser models.
 		if taken {
 			return ErrEmailTaken
 		}
-		updates = append(updates, firestore.Update{
-			Path:  "email",
-			Value: updatedUser.Email,
-		})
+		update.Email = &updatedUser.Email
+		update.EmailVerified = new(bool)
 		changes = true
 	}
 
 	if updatedUser.Roles != nil && !equalRoles(updatedUser.Roles, existingUser.Roles) {
-		updates = append(updates, firestore.Update{
-			Path:  "roles",
-			Value: updatedUser.Roles,
-		})
+		update.Roles = updatedUser.Roles
+		update.BumpTokenVersion = true
 		changes = true
 	}
 
@@ -66,19 +57,14 @@ func (s *UserService) Update(ctx context.Context, id string, updatedUser models.
 			if err != nil {
 				return fmt.Errorf("failed to hash password: %w", err)
 			}
-			updates = append(updates, firestore.Update{
-				Path:  "passwordHash",
-				Value: hashedPassword,
-			})
+			passwordHash := string(hashedPassword)
+			update.PasswordHash = &passwordHash
 			changes = true
 		}
 	}
 
 	if updatedUser.Name != "" && updatedUser.Name != existingUser.Name {
-		updates = append(updates, firestore.Update{
-			Path:  "name",
-			Value: updatedUser.Name,
-		})
+		update.Name = &updatedUser.Name
 		changes = true
 	}
 
@@ -86,13 +72,7 @@ func (s *UserService) Update(ctx context.Context, id string, updatedUser models.
 		return ErrNoChangesDetected
 	}
 
-	updates = append(updates, firestore.Update{
-		Path:  "updatedAt",
-		Value: firestore.ServerTimestamp,
-	})
-
-	_, err = s.collection.Doc(id).Update(ctx, updates)
-	return err
+	return s.repo.Update(ctx, id, update)
 }
 
 func equalRoles(a, b []string) bool {
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+)
+
+// MarkEmailVerified records that the user proved to own its current email.
+func (s *UserService) MarkEmailVerified(ctx context.Context, id string) error {
+	verified := true
+	return s.repo.Update(ctx, id, UserUpdate{EmailVerified: &verified})
+}
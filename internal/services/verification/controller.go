@@ -0,0 +1,42 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"nitelog/internal/mailer"
+	userServices "nitelog/internal/services/user"
+)
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	ErrEmailAlreadyVerified     = errors.New("email already verified")
+)
+
+// VerificationService proves users own the email they registered with.
+// Tokens are signed rather than stored, they name the email they were sent
+// to so changing the email invalidates them.
+type VerificationService struct {
+	userService *userServices.UserService
+	mailer      mailer.Mailer
+	secret      string
+	verifyURL   string
+	ttl         time.Duration
+}
+
+// NewVerificationService emails links pointing at verifyURL, valid for ttl.
+func NewVerificationService(
+	userService *userServices.UserService,
+	mailer mailer.Mailer,
+	secret string,
+	verifyURL string,
+	ttl time.Duration,
+) *VerificationService {
+	return &VerificationService{
+		userService: userService,
+		mailer:      mailer,
+		secret:      secret,
+		verifyURL:   verifyURL,
+		ttl:         ttl,
+	}
+}
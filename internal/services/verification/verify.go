@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"nitelog/internal/mailer"
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// tokenAudience keeps verification tokens from being accepted as access
+// tokens and the other way around, both are signed with the same secret.
+const tokenAudience = "email-verification"
+
+type verificationClaims struct {
+	jwt.StandardClaims
+	Email string `json:"email"`
+}
+
+// Send emails a verification link to the user.
+func (s *VerificationService) Send(ctx context.Context, user *models.User) error {
+	if user.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := s.token(user)
+	if err != nil {
+		return err
+	}
+
+	link := s.verifyURL + "?token=" + url.QueryEscape(token)
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Confirme seu email no NITELog",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nPara confirmar que este email é seu e ativar a sua conta no NITELog, acesse:\n\n%s\n\n"+
+				"O link expira em %d horas. Se você não criou esta conta, ignore este email.\n",
+			user.Name, link, int(s.ttl.Hours()),
+		),
+	})
+}
+
+// SendByID is Send for callers holding only the user id.
+func (s *VerificationService) SendByID(ctx context.Context, id string) error {
+	user, err := s.userService.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.Send(ctx, user)
+}
+
+// Verify marks the email the token was sent to as verified. Verifying twice
+// is not an error.
+func (s *VerificationService) Verify(ctx context.Context, token string) (*models.User, error) {
+	claims := &verificationClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(s.secret), nil
+	})
+	if err != nil || !claims.VerifyAudience(tokenAudience, true) {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	user, err := s.userService.GetByID(ctx, claims.Subject)
+	if errors.Is(err, userServices.ErrUserNotFound) {
+		return nil, ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email != claims.Email {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	if user.IsEmailVerified() {
+		return user, nil
+	}
+
+	if err := s.userService.MarkEmailVerified(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return s.userService.GetByID(ctx, user.ID)
+}
+
+// Confirm marks the email of the user as verified without a token, for
+// admins vouching for an account.
+func (s *VerificationService) Confirm(ctx context.Context, id string) (*models.User, error) {
+	user, err := s.userService.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsEmailVerified() {
+		return nil, ErrEmailAlreadyVerified
+	}
+
+	if err := s.userService.MarkEmailVerified(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.userService.GetByID(ctx, id)
+}
+
+func (s *VerificationService) token(user *models.User) (string, error) {
+	now := time.Now()
+	claims := verificationClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  tokenAudience,
+			Subject:   user.ID,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(s.ttl).Unix(),
+		},
+		Email: user.Email,
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.secret))
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"nitelog/internal/mailer"
+	"nitelog/internal/models"
+	userServices "nitelog/internal/services/user"
+	"nitelog/internal/util"
+)
+
+// testMailer keeps the last email sent.
+type testMailer struct {
+	last mailer.Message
+}
+
+func (m *testMailer) Send(ctx context.Context, message mailer.Message) error {
+	m.last = message
+	return nil
+}
+
+// token reads the verification token from the link of the last email.
+func (m *testMailer) token(t *testing.T) string {
+	t.Helper()
+
+	_, link, ok := strings.Cut(m.last.Body, "?token=")
+	if !ok {
+		t.Fatalf("email without a verification link: %q", m.last.Body)
+	}
+
+	token, err := url.QueryUnescape(strings.Fields(link)[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	mail := &testMailer{}
+	verification := NewVerificationService(users, mail, "test", "https://example.com/verify", time.Hour)
+
+	user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verification.Send(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	token := mail.token(t)
+
+	accessToken, err := util.GenerateJWT(models.Principal{UserID: user.ID, SessionID: "session"}, "test", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verification.Verify(ctx, accessToken); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("Verify(access token) error = %v, want ErrInvalidVerificationToken", err)
+	}
+
+	verified, err := verification.Verify(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !verified.IsEmailVerified() {
+		t.Error("email not verified")
+	}
+
+	if _, err := verification.Verify(ctx, token); err != nil {
+		t.Errorf("Verify(twice) error = %v, want nil", err)
+	}
+
+	if err := verification.Send(ctx, verified); !errors.Is(err, ErrEmailAlreadyVerified) {
+		t.Errorf("Send(verified) error = %v, want ErrEmailAlreadyVerified", err)
+	}
+}
+
+func TestVerifyAfterEmailChange(t *testing.T) {
+	ctx := context.Background()
+	users := userServices.NewUserService(userServices.NewMemoryUserRepository())
+	mail := &testMailer{}
+	verification := NewVerificationService(users, mail, "test", "https://example.com/verify", time.Hour)
+
+	user, err := users.Create(ctx, "1", "ana@example.com", "Ana", []byte("hash"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verification.Send(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	token := mail.token(t)
+
+	if err := users.Update(ctx, user.ID, models.User{Email: "ana@example.org"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verification.Verify(ctx, token); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("Verify(old email) error = %v, want ErrInvalidVerificationToken", err)
+	}
+}
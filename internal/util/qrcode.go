@@ -0,0 +1,59 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// ParseQRCodeLevel maps the usual L/M/Q/H error correction names to the
+// encoder recovery levels.
+func ParseQRCodeLevel(level string) (qrcode.RecoveryLevel, error) {
+	switch strings.ToUpper(level) {
+	case "L":
+		return qrcode.Low, nil
+	case "", "M":
+		return qrcode.Medium, nil
+	case "Q":
+		return qrcode.High, nil
+	case "H":
+		return qrcode.Highest, nil
+	default:
+		return 0, fmt.Errorf("invalid error correction level %q, expected L, M, Q or H", level)
+	}
+}
+
+func RenderQRCodePNG(content string, level qrcode.RecoveryLevel, size int) ([]byte, error) {
+	return qrcode.Encode(content, level, size)
+}
+
+// RenderQRCodeSVG draws the QR code as one path of unit squares scaled to
+// size pixels, keeping the quiet zone produced by the encoder.
+func RenderQRCodeSVG(content string, level qrcode.RecoveryLevel, size int) ([]byte, error) {
+	code, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := code.Bitmap()
+	modules := len(bitmap)
+
+	var path strings.Builder
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&path, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+
+	svg := fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="100%%" height="100%%" fill="#ffffff"/>`+
+			`<path fill="#000000" d="%s"/></svg>`,
+		size, size, modules, modules, path.String(),
+	)
+
+	return []byte(svg), nil
+}
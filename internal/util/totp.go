@@ -0,0 +1,78 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 encoded secret suitable for
+// TOTP.
+func GenerateTOTPSecret() string {
+	b := make([]byte, 20)
+	rand.Read(b)
+	return totpEncoding.EncodeToString(b)
+}
+
+// TOTPCounter returns the time step t falls into for the given period.
+func TOTPCounter(t time.Time, period time.Duration) uint64 {
+	return uint64(t.Unix() / int64(period/time.Second))
+}
+
+// TOTP computes the RFC 6238 code of the base32 secret for a time step.
+func TOTP(secret string, counter uint64, digits int) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("invalid totp secret: %w", err)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+// ValidateTOTP reports whether code matches the secret at t or at any of the
+// previous skew time steps.
+func ValidateTOTP(secret, code string, t time.Time, period time.Duration, digits, skew int) bool {
+	_, ok := MatchTOTP(secret, code, t, period, digits, skew)
+	return ok
+}
+
+// MatchTOTP is ValidateTOTP that also returns the time step the code belongs
+// to, so callers can refuse a code that was already used.
+func MatchTOTP(secret, code string, t time.Time, period time.Duration, digits, skew int) (uint64, bool) {
+	counter := TOTPCounter(t, period)
+
+	for i := 0; i <= skew && uint64(i) <= counter; i++ {
+		expected, err := TOTP(secret, counter-uint64(i), digits)
+		if err != nil {
+			return 0, false
+		}
+
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return counter - uint64(i), true
+		}
+	}
+
+	return 0, false
+}
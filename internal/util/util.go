@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"nitelog/internal/config"
+	"nitelog/internal/models"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -28,6 +29,12 @@ type MessageResponse struct {
 	Message string  `json:"message" example:"Sample status message"`
 }
 
+// @model ValidationErrorResponse
+type ValidationErrorResponse struct {
+	Error  string            `json:"error" example:"validation failed"`
+	Fields map[string]string `json:"fields"`
+}
+
 func NormalizeDate(date time.Time) (*time.Time, error) {
 	cfg := config.Load()
 	location, err := time.LoadLocation(cfg.Timezone)
@@ -47,12 +54,58 @@ func NormalizeDate(date time.Time) (*time.Time, error) {
 	return &normalizedDate, nil
 }
 
+// LocalDate returns the normalized date of the day t falls on in the
+// configured timezone, so a check-in at 22:00 local time still belongs to
+// that day even though it is already the next day in UTC.
+func LocalDate(t time.Time) (*time.Time, error) {
+	cfg := config.Load()
+	location, err := time.LoadLocation(cfg.Timezone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NormalizeDate(t.In(location))
+}
+
 func GenerateMeetingCode() string {
 	b := make([]byte, 6)
 	rand.Read(b)
 	return base64.URLEncoding.EncodeToString(b)[:8]
 }
 
+func GenerateID() string {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+	b := make([]byte, 20)
+	rand.Read(b)
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b)
+}
+
+// codeAlphabet leaves out letters and digits easily mistaken for each other
+// when a code is typed from paper or read aloud.
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GenerateCode returns a random code of groups dash separated groups of
+// four characters, like K7QX-M4TZ-9PWR for three, for codes people type.
+func GenerateCode(groups int) string {
+	b := make([]byte, groups*4)
+	rand.Read(b)
+
+	code := make([]byte, 0, groups*5)
+	for i, v := range b {
+		if i > 0 && i%4 == 0 {
+			code = append(code, '-')
+		}
+		code = append(code, codeAlphabet[int(v)%len(codeAlphabet)])
+	}
+
+	return string(code)
+}
+
 func HashPassword(password string) ([]byte, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -72,16 +125,100 @@ func ParseDate(date string) (time.Time, error) {
 	return time.Parse("2006-01-02", date)
 }
 
-func GenerateJWT(userID, secret string) (string, error) {
-	expirationTime := 24 * time.Hour
-	claims := jwt.StandardClaims{
-		Subject:   userID,
-		ExpiresAt: time.Now().Add(expirationTime).Unix(),
+// InvalidDateError reports a date query parameter that is not in the
+// 2006-01-02 format.
+type InvalidDateError struct {
+	Param string
+}
+
+func (e *InvalidDateError) Error() string {
+	return "Invalid " + e.Param + " date format"
+}
+
+// ParseDateRange parses the optional from and to query values into
+// normalized dates, an empty value is left nil. A malformed value returns an
+// *InvalidDateError.
+func ParseDateRange(fromValue, toValue string) (from, to *time.Time, err error) {
+	for _, param := range []struct {
+		name   string
+		value  string
+		target **time.Time
+	}{
+		{"from", fromValue, &from},
+		{"to", toValue, &to},
+	} {
+		if param.value == "" {
+			continue
+		}
+
+		date, err := ParseDate(param.value)
+		if err != nil {
+			return nil, nil, &InvalidDateError{Param: param.name}
+		}
+
+		*param.target, err = NormalizeDate(date)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return from, to, nil
+}
+
+// Claims are the claims of an access token. The session id is carried as
+// the token id so revoking the session revokes the token.
+type Claims struct {
+	jwt.StandardClaims
+	Roles             []string `json:"roles,omitempty"`
+	TokenVersion      int      `json:"ver"`
+	TwoFactor         bool     `json:"mfa,omitempty"`
+	TwoFactorRequired bool     `json:"mfa_required,omitempty"`
+}
+
+func (claims *Claims) Principal() *models.Principal {
+	return &models.Principal{
+		UserID:            claims.Subject,
+		SessionID:         claims.Id,
+		Roles:             claims.Roles,
+		TokenVersion:      claims.TokenVersion,
+		TwoFactor:         claims.TwoFactor,
+		TwoFactorRequired: claims.TwoFactorRequired,
+	}
+}
+
+func GenerateJWT(principal models.Principal, secret string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        principal.SessionID,
+			Subject:   principal.UserID,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+		Roles:             principal.Roles,
+		TokenVersion:      principal.TokenVersion,
+		TwoFactor:         principal.TwoFactor,
+		TwoFactorRequired: principal.TwoFactorRequired,
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
 }
 
+// GetPrincipal returns the caller set by the JWT middleware.
+func GetPrincipal(ginContext *gin.Context) (*models.Principal, error) {
+	value, exists := ginContext.Get("principal")
+	if !exists {
+		return nil, errors.New("error getting principal from token")
+	}
+
+	principal, ok := value.(*models.Principal)
+	if !ok {
+		return nil, errors.New("error parsing principal from token")
+	}
+
+	return principal, nil
+}
+
 func GetAuthJWT(ginContext *gin.Context) (string, error) {
 	userTokenID, exists := ginContext.Get("userID")
 	if !exists {
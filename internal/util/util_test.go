@@ -0,0 +1,55 @@
+package util
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseDateRange(t *testing.T) {
+	// NormalizeDate reads the timezone through config.Load.
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("NITELOG_STORAGE", "memory")
+	t.Setenv("NITELOG_TIMEZONE", "America/Sao_Paulo")
+
+	from, to, err := ParseDateRange("2025-09-01", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if to != nil {
+		t.Errorf("to = %v, want nil", to)
+	}
+
+	if from == nil || from.Format("2006-01-02T15:04:05Z07:00") != "2025-09-01T03:00:00Z" {
+		t.Errorf("from = %v, want midnight in Sao Paulo", from)
+	}
+
+	for _, tt := range []struct {
+		from, to, param string
+	}{
+		{"01/09/2025", "", "from"},
+		{"2025-09-01", "2025-13-01", "to"},
+	} {
+		_, _, err := ParseDateRange(tt.from, tt.to)
+
+		var invalidDate *InvalidDateError
+		if !errors.As(err, &invalidDate) || invalidDate.Param != tt.param {
+			t.Errorf("ParseDateRange(%q, %q) = %v, want an invalid %s date", tt.from, tt.to, err, tt.param)
+		}
+	}
+}
+
+func TestGenerateCode(t *testing.T) {
+	code := GenerateCode(3)
+
+	if len(code) != 14 || code[4] != '-' || code[9] != '-' {
+		t.Fatalf("GenerateCode(3) = %q, want three dash separated groups of four", code)
+	}
+
+	for i, c := range code {
+		if i%5 != 4 && !strings.ContainsRune(codeAlphabet, c) {
+			t.Errorf("GenerateCode(3) = %q, %q is not in the code alphabet", code, c)
+		}
+	}
+}
@@ -10,8 +10,22 @@ import (
 	"os/signal"
 
 	"nitelog/internal/config"
+	"nitelog/internal/database"
+	"nitelog/internal/jobs"
+	"nitelog/internal/mailer"
 	"nitelog/internal/routes"
-	"nitelog/internal/services"
+	authServices "nitelog/internal/services/auth"
+	certificateServices "nitelog/internal/services/certificate"
+	exportServices "nitelog/internal/services/export"
+	inviteServices "nitelog/internal/services/invite"
+	meetingServices "nitelog/internal/services/meeting"
+	passwordServices "nitelog/internal/services/password"
+	purgeServices "nitelog/internal/services/purge"
+	reportServices "nitelog/internal/services/report"
+	scheduleServices "nitelog/internal/services/schedule"
+	twoFactorServices "nitelog/internal/services/twofactor"
+	userServices "nitelog/internal/services/user"
+	verificationServices "nitelog/internal/services/verification"
 
 	"cloud.google.com/go/firestore"
 	"github.com/gin-gonic/gin"
@@ -42,35 +56,121 @@ func main() {
 		err = godotenv.Load(".env.dev")
 	}
 
-	cfg := config.Load()
-
 	if err != nil {
-		log.Fatal("Error loading .env file")
+		log.Printf("no env file loaded: %v", err)
 	}
+
+	cfg := config.Load()
 	log.Printf("running in %s environment", devEnv)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	client, err := firestore.NewClient(ctx, cfg.ProjectID)
-	if err != nil {
-		log.Fatal("Failed to create Firestore client: ", err)
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		db := connectSQL(ctx, cfg.Storage, cfg.DatabaseURL)
+		defer db.Close()
+
+		if err := database.Migrate(ctx, db); err != nil {
+			log.Fatal("Migration failed: ", err)
+		}
+		log.Println("Migrations applied")
+		return
 	}
-	defer client.Close()
 
-	_, err = client.Collection("test").Doc("test").Get(ctx)
-	if err != nil {
-		if status.Code(err) != codes.NotFound {
-			log.Fatal("Firestore connection check failed: ", err)
+	var svc routes.Services
+	var meetings meetingServices.MeetingRepository
+	var schedules scheduleServices.ScheduleRepository
+	var certificates certificateServices.CertificateRepository
+	var sessions authServices.SessionRepository
+	var resets passwordServices.ResetRepository
+	var invites inviteServices.InviteRepository
+	var twoFactors twoFactorServices.TwoFactorRepository
+	switch cfg.Storage {
+	case config.StorageMemory:
+		log.Println("using in-memory storage, data will be lost on shutdown")
+
+		meetings = meetingServices.NewMemoryMeetingRepository()
+		svc.Users = userServices.NewUserService(userServices.NewMemoryUserRepository())
+		schedules = scheduleServices.NewMemoryScheduleRepository()
+		certificates = certificateServices.NewMemoryCertificateRepository()
+		sessions = authServices.NewMemorySessionRepository()
+		resets = passwordServices.NewMemoryResetRepository()
+		invites = inviteServices.NewMemoryInviteRepository()
+		twoFactors = twoFactorServices.NewMemoryTwoFactorRepository()
+	case config.StorageSQLite, config.StoragePostgres:
+		db := connectSQL(ctx, cfg.Storage, cfg.DatabaseURL)
+		defer db.Close()
+
+		if cfg.AutoMigrate {
+			if err := database.Migrate(ctx, db); err != nil {
+				log.Fatal("Migration failed: ", err)
+			}
 		}
 
-		log.Println("Firestore connection verified")
+		meetings = meetingServices.NewSQLMeetingRepository(db)
+		svc.Users = userServices.NewUserService(userServices.NewSQLUserRepository(db))
+		schedules = scheduleServices.NewSQLScheduleRepository(db)
+		certificates = certificateServices.NewSQLCertificateRepository(db)
+		sessions = authServices.NewSQLSessionRepository(db)
+		resets = passwordServices.NewSQLResetRepository(db)
+		invites = inviteServices.NewSQLInviteRepository(db)
+		twoFactors = twoFactorServices.NewSQLTwoFactorRepository(db)
+	default:
+		client := connectFirestore(ctx, cfg.ProjectID)
+		defer client.Close()
+
+		meetings = meetingServices.NewFirestoreMeetingRepository(client)
+		svc.Users = userServices.NewUserService(userServices.NewFirestoreUserRepository(client))
+		schedules = scheduleServices.NewFirestoreScheduleRepository(client)
+		certificates = certificateServices.NewFirestoreCertificateRepository(client)
+		sessions = authServices.NewFirestoreSessionRepository(client)
+		resets = passwordServices.NewFirestoreResetRepository(client)
+		invites = inviteServices.NewFirestoreInviteRepository(client)
+		twoFactors = twoFactorServices.NewFirestoreTwoFactorRepository(client)
 	}
 
-	services.SetFirestoreClient(client)
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		log.Fatalf("invalid timezone %q: %v", cfg.Timezone, err)
+	}
+
+	svc.Meetings = meetingServices.NewMeetingService(meetings, cfg.CodePeriod, cfg.CheckInURL)
+	svc.Certificates = certificateServices.NewCertificateService(certificates, svc.Meetings, svc.Users, location, cfg.CertificateVerifyURL)
+	svc.Schedules = scheduleServices.NewScheduleService(schedules, svc.Meetings, cfg.ScheduleHorizonDays, location)
+	svc.Reports = reportServices.NewReportService(svc.Meetings, svc.Users, location)
+	svc.Exports = exportServices.NewExportService(svc.Meetings, svc.Users, location)
+	svc.Auth = authServices.NewAuthService(
+		sessions, svc.Users, cfg.JWTSecret, cfg.AccessTokenTTL, cfg.RefreshTokenTTL, cfg.TwoFactorRoles,
+	)
+	mail := newMailer(cfg)
+	svc.Passwords = passwordServices.NewPasswordService(
+		resets, svc.Users, svc.Auth, mail,
+		cfg.PasswordResetURL, cfg.PasswordResetTTL, cfg.PasswordResetLimit, cfg.PasswordResetWindow,
+	)
+	svc.Verification = verificationServices.NewVerificationService(
+		svc.Users, mail, cfg.JWTSecret, cfg.EmailVerifyURL, cfg.EmailVerifyTTL,
+	)
+	svc.Invites = inviteServices.NewInviteService(invites, cfg.RegistrationPolicy, cfg.RegistrationDomains, cfg.InviteTTL)
+	svc.TwoFactor = twoFactorServices.NewTwoFactorService(
+		twoFactors, svc.Users, svc.Auth, cfg.JWTSecret, cfg.TwoFactorIssuer, cfg.TwoFactorChallengeTTL,
+	)
+	svc.Purge = purgeServices.NewPurgeService(
+		svc.Meetings, svc.Users, svc.Certificates, svc.TwoFactor, svc.Passwords, cfg.PurgeRetention,
+	)
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	go jobs.Every(jobsCtx, "purge", cfg.PurgeInterval, time.Minute, svc.Purge.Run)
+	go jobs.Every(jobsCtx, "sessions", cfg.PurgeInterval, time.Minute, svc.Auth.Run)
+	go jobs.Every(jobsCtx, "password-resets", cfg.PurgeInterval, time.Minute, svc.Passwords.Run)
+	go jobs.Every(jobsCtx, "schedules", cfg.ScheduleInterval, time.Minute, svc.Schedules.Run)
+
+	autoCloser := meetingServices.NewAutoCloser(svc.Meetings, cfg.AutoCloseCutoff, cfg.AutoCloseLookbackDays, location)
+	go jobs.Every(jobsCtx, "auto-close", cfg.AutoCloseInterval, time.Minute, autoCloser.Run)
 
 	router := gin.Default()
-	routes.RegisterRoutes(router, client)
+	routes.RegisterRoutes(router, svc)
 
 	// Graceful shutdown
 	srv := &http.Server{
@@ -88,6 +188,7 @@ func main() {
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -96,3 +197,60 @@ func main() {
 	}
 	log.Println("Server exiting")
 }
+
+func connectFirestore(ctx context.Context, projectID string) *firestore.Client {
+	client, err := firestore.NewClient(ctx, projectID)
+	if err != nil {
+		log.Fatal("Failed to create Firestore client: ", err)
+	}
+
+	_, err = client.Collection("test").Doc("test").Get(ctx)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Fatal("Firestore connection check failed: ", err)
+		}
+
+		log.Println("Firestore connection verified")
+	}
+
+	return client
+}
+
+func connectSQL(ctx context.Context, dialect, dsn string) *database.DB {
+	if dialect != config.StorageSQLite && dialect != config.StoragePostgres {
+		log.Fatalf("storage backend %q is not a SQL database", dialect)
+	}
+
+	db, err := database.Open(dialect, dsn)
+	if err != nil {
+		log.Fatal("Failed to open database: ", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		log.Fatal("Database connection check failed: ", err)
+	}
+
+	log.Printf("%s connection verified", dialect)
+
+	return db
+}
+
+func newMailer(cfg *config.Config) mailer.Mailer {
+	if cfg.Mailer == config.MailerSMTP {
+		log.Printf("sending emails through %s", cfg.SMTPHost)
+		return mailer.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+	}
+
+	if cfg.MailFile == "" {
+		log.Println("emails are written to the log and not sent")
+		return mailer.NewLogMailer(log.Writer())
+	}
+
+	file, err := os.OpenFile(cfg.MailFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		log.Fatal("Failed to open mail file: ", err)
+	}
+
+	log.Printf("emails are written to %s and not sent", cfg.MailFile)
+	return mailer.NewLogMailer(file)
+}